@@ -6,7 +6,10 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/urfave/cli/v2"
 
+	"server/internal/cmd/avatar"
+	"server/internal/cmd/crashsink"
 	"server/internal/cmd/migrate"
+	"server/internal/cmd/openapi"
 	"server/internal/cmd/serve"
 	"server/internal/flags"
 	"server/pkg/logger"
@@ -21,6 +24,9 @@ func main() {
 		Commands: []*cli.Command{
 			serve.NewCommand(),
 			migrate.NewCommand(),
+			avatar.NewCommand(),
+			openapi.NewCommand(),
+			crashsink.NewCommand(),
 		},
 	}
 
@@ -0,0 +1,149 @@
+// Package apperror 애플리케이션 공통 에러 타입
+// 리포지토리/서비스는 *Error(또는 그것을 감싼 에러)를 반환하고, 핸들러/미들웨어는 Write 로 공통 JSON 응답을 만든다
+package apperror
+
+import (
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// Type 에러 분류 (HTTP 상태 코드와 기본 code/message 를 결정)
+type Type string
+
+const (
+	TypeBadRequest       Type = "BAD_REQUEST"
+	TypeValidation       Type = "VALIDATION_FAILED"
+	TypeUnauthorized     Type = "UNAUTHORIZED"
+	TypeForbidden        Type = "FORBIDDEN"
+	TypeNotFound         Type = "NOT_FOUND"
+	TypeMethodNotAllowed Type = "METHOD_NOT_ALLOWED"
+	TypeConflict         Type = "CONFLICT"
+	TypeGone             Type = "GONE"
+	TypeTooLarge         Type = "PAYLOAD_TOO_LARGE"
+	TypeUnsupportedMedia Type = "UNSUPPORTED_MEDIA_TYPE"
+	TypeTooManyRequests  Type = "TOO_MANY_REQUESTS"
+	TypeExternal         Type = "EXTERNAL_SERVICE_ERROR"
+	TypeUnavailable      Type = "SERVICE_UNAVAILABLE"
+	TypeInternal         Type = "INTERNAL_ERROR"
+)
+
+type typeInfo struct {
+	status  int
+	message string
+}
+
+var types = map[Type]typeInfo{
+	TypeBadRequest:       {http.StatusBadRequest, "잘못된 요청입니다."},
+	TypeValidation:       {http.StatusUnprocessableEntity, "입력값을 확인해주세요."},
+	TypeUnauthorized:     {http.StatusUnauthorized, "로그인이 필요합니다."},
+	TypeForbidden:        {http.StatusForbidden, "권한이 없습니다."},
+	TypeNotFound:         {http.StatusNotFound, "요청한 리소스를 찾을 수 없습니다."},
+	TypeMethodNotAllowed: {http.StatusMethodNotAllowed, "허용되지 않은 메서드입니다."},
+	TypeConflict:         {http.StatusConflict, "요청이 현재 상태와 충돌합니다."},
+	TypeGone:             {http.StatusGone, "더 이상 사용할 수 없는 리소스입니다."},
+	TypeTooLarge:         {http.StatusRequestEntityTooLarge, "요청 본문이 너무 큽니다."},
+	TypeUnsupportedMedia: {http.StatusUnsupportedMediaType, "지원하지 않는 Content-Type 입니다."},
+	TypeTooManyRequests:  {http.StatusTooManyRequests, "요청이 너무 많습니다. 잠시 후 다시 시도해주세요."},
+	TypeExternal:         {http.StatusBadGateway, "외부 서비스 요청에 실패했습니다."},
+	TypeUnavailable:      {http.StatusServiceUnavailable, "일시적으로 요청을 처리할 수 없습니다. 잠시 후 다시 시도해주세요."},
+	TypeInternal:         {http.StatusInternalServerError, "예기치 못한 서버 오류입니다."},
+}
+
+// Error 타입 + 안정적인 code(프론트 분기용) + 사용자 메시지 + 부가 정보
+// 같은 Type/Code 의 Error 는 errors.Is 로 같은 에러로 취급 (메시지/상세만 다른 복사본 포함)
+type Error struct {
+	Type    Type
+	Code    string
+	Message string
+	Details map[string]interface{}
+	cause   error
+}
+
+// New code 가 비어 있으면 Type 이름, message 가 비어 있으면 Type 기본 메시지 사용
+func New(t Type, code, message string) *Error {
+	if code == "" {
+		code = string(t)
+	}
+	if message == "" {
+		message = types[t].message
+	}
+	return &Error{Type: t, Code: code, Message: message}
+}
+
+func BadRequest(code, message string) *Error   { return New(TypeBadRequest, code, message) }
+func Unauthorized(code, message string) *Error { return New(TypeUnauthorized, code, message) }
+func Forbidden(code, message string) *Error    { return New(TypeForbidden, code, message) }
+func NotFound(code, message string) *Error     { return New(TypeNotFound, code, message) }
+func Conflict(code, message string) *Error     { return New(TypeConflict, code, message) }
+func External(code, message string) *Error     { return New(TypeExternal, code, message) }
+
+// Internal 원인 에러를 감싼 500 (원인은 로그에만 남고 응답에는 노출되지 않음)
+func Internal(cause error) *Error {
+	return New(TypeInternal, "", "").Wrap(cause)
+}
+
+func (e *Error) Error() string {
+	msg := e.Code + ": " + e.Message
+	if e.cause != nil {
+		msg += ": " + e.cause.Error()
+	}
+	return msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Type == e.Type && t.Code == e.Code
+}
+
+// Status HTTP 상태 코드
+func (e *Error) Status() int {
+	if info, ok := types[e.Type]; ok {
+		return info.status
+	}
+	return http.StatusInternalServerError
+}
+
+// WithMessage 메시지만 바꾼 복사본 (패키지 변수로 둔 sentinel 은 수정하지 않음)
+func (e *Error) WithMessage(message string) *Error {
+	c := e.clone()
+	c.Message = message
+	return c
+}
+
+// WithDetail 상세 정보를 추가한 복사본
+func (e *Error) WithDetail(key string, value interface{}) *Error {
+	c := e.clone()
+	details := make(map[string]interface{}, len(e.Details)+1)
+	for k, v := range e.Details {
+		details[k] = v
+	}
+	details[key] = value
+	c.Details = details
+	return c
+}
+
+// Wrap 원인 에러를 연결한 복사본
+func (e *Error) Wrap(cause error) *Error {
+	c := e.clone()
+	c.cause = cause
+	return c
+}
+
+func (e *Error) clone() *Error {
+	c := *e
+	return &c
+}
+
+// As err 체인에서 *Error 를 찾음
+func As(err error) (*Error, bool) {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr, true
+	}
+	return nil, false
+}
@@ -0,0 +1,59 @@
+package apperror
+
+import (
+	"encoding/json"
+	"net/http"
+	"server/internal/requestid"
+	"server/pkg/validator"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// Response 모든 에러 응답의 공통 JSON 본문
+type Response struct {
+	Code      string                 `json:"code"`
+	Message   string                 `json:"message"`
+	RequestID string                 `json:"request_id,omitempty"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+}
+
+// From 임의의 에러를 *Error 로 변환
+// validator.Errors 는 422 (details.fields), 분류되지 않은 에러는 500
+func From(err error) *Error {
+	if appErr, ok := As(err); ok {
+		return appErr
+	}
+	var verrs validator.Errors
+	if errors.As(err, &verrs) {
+		return New(TypeValidation, "", "").WithDetail("fields", verrs).Wrap(err)
+	}
+	return Internal(err)
+}
+
+// Write 에러를 공통 JSON 응답으로 기록
+// 5xx 는 원인 에러 전체를 error 레벨로, 4xx 는 debug 레벨로 요청 로거에 로그 (메서드/경로는 접근 로그에)
+func Write(w http.ResponseWriter, r *http.Request, err error) {
+	appErr := From(err)
+	status := appErr.Status()
+
+	logger := log.Ctx(r.Context())
+	event := logger.Debug()
+	if status >= http.StatusInternalServerError {
+		event = logger.Error()
+	}
+	event.Err(err).
+		Str("code", appErr.Code).
+		Int("status", status).
+		Msg("[apperror.Write] request failed")
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(Response{
+		Code:      appErr.Code,
+		Message:   appErr.Message,
+		RequestID: requestid.FromContext(r.Context()),
+		Details:   appErr.Details,
+	})
+}
@@ -0,0 +1,83 @@
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+// TTL 항목마다 만료 시각이 있는 인스턴스 메모리 캐시 (여러 goroutine 에서 사용 가능)
+// 만료된 항목은 조회 시 지우고, 나머지는 Set 에서 TTL 주기로 한 번씩 전체를 훑어 정리
+// (항목이 많아도 매 Set 마다 전체를 훑지 않으므로 Set 비용은 분할 상환 O(1))
+type TTL[K comparable, V any] struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu        sync.Mutex
+	items     map[K]ttlEntry[V]
+	nextSweep time.Time
+}
+
+type ttlEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+func NewTTL[K comparable, V any](ttl time.Duration) *TTL[K, V] {
+	return &TTL[K, V]{
+		ttl:   ttl,
+		now:   time.Now,
+		items: make(map[K]ttlEntry[V]),
+	}
+}
+
+// Get 만료되지 않은 값 (없거나 만료됐으면 false)
+func (c *TTL[K, V]) Get(key K) (V, bool) {
+	now := c.now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	if !now.Before(e.expiresAt) {
+		delete(c.items, key)
+		var zero V
+		return zero, false
+	}
+	return e.value, true
+}
+
+// Set 지금부터 TTL 동안 유효한 값 저장
+func (c *TTL[K, V]) Set(key K, value V) {
+	now := c.now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items[key] = ttlEntry[V]{value: value, expiresAt: now.Add(c.ttl)}
+	if !now.Before(c.nextSweep) {
+		c.sweepLocked(now)
+		c.nextSweep = now.Add(c.ttl)
+	}
+}
+
+// Delete 값이 바뀐 직후 무효화
+func (c *TTL[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.items, key)
+}
+
+// Len 아직 정리되지 않은 만료 항목을 포함한 항목 수
+func (c *TTL[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.items)
+}
+
+func (c *TTL[K, V]) sweepLocked(now time.Time) {
+	for k, e := range c.items {
+		if !now.Before(e.expiresAt) {
+			delete(c.items, k)
+		}
+	}
+}
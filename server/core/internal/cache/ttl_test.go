@@ -0,0 +1,84 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+// fakeClock 테스트에서 시간을 직접 진행
+type fakeClock struct{ t time.Time }
+
+func (c *fakeClock) now() time.Time { return c.t }
+
+func newTestTTL(ttl time.Duration) (*TTL[int, string], *fakeClock) {
+	clock := &fakeClock{t: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	c := NewTTL[int, string](ttl)
+	c.now = clock.now
+	return c, clock
+}
+
+func TestTTLGet(t *testing.T) {
+	c, clock := newTestTTL(time.Minute)
+	c.Set(1, "a")
+
+	tests := []struct {
+		name    string
+		elapsed time.Duration
+		wantOK  bool
+	}{
+		{"fresh", 0, true},
+		{"just before expiry", time.Minute - time.Nanosecond, true},
+		{"at expiry", time.Minute, false},
+		{"deleted on expired get", time.Minute, false},
+	}
+	start := clock.t
+	for _, tt := range tests {
+		clock.t = start.Add(tt.elapsed)
+		v, ok := c.Get(1)
+		if ok != tt.wantOK || (ok && v != "a") {
+			t.Errorf("%s: Get = (%q, %v), want ok=%v", tt.name, v, ok, tt.wantOK)
+		}
+	}
+	if c.Len() != 0 {
+		t.Errorf("Len = %d, want expired entry removed by Get", c.Len())
+	}
+}
+
+func TestTTLSetRefreshesAndDelete(t *testing.T) {
+	c, clock := newTestTTL(time.Minute)
+	c.Set(1, "a")
+	clock.t = clock.t.Add(50 * time.Second)
+	c.Set(1, "b")
+	clock.t = clock.t.Add(50 * time.Second)
+	if v, ok := c.Get(1); !ok || v != "b" {
+		t.Errorf("Get after refresh = (%q, %v), want b", v, ok)
+	}
+	c.Delete(1)
+	if _, ok := c.Get(1); ok {
+		t.Error("Get after Delete returned a value")
+	}
+}
+
+// TestTTLSweep 만료 항목은 TTL 주기마다 Set 에서 한 번에 정리 (그 사이 Set 은 전체를 훑지 않음)
+func TestTTLSweep(t *testing.T) {
+	c, clock := newTestTTL(time.Minute)
+	for id := 0; id < 100; id++ {
+		c.Set(id, "old") // 첫 Set 에서 정리 후 다음 정리는 1분 뒤
+	}
+	clock.t = clock.t.Add(30 * time.Second)
+	c.Set(1000, "mid")
+	if c.Len() != 101 {
+		t.Fatalf("Len = %d, want 101 (no sweep before the interval)", c.Len())
+	}
+
+	clock.t = clock.t.Add(31 * time.Second) // old 만료, mid 유효
+	c.Set(2000, "new")
+	if c.Len() != 2 {
+		t.Errorf("Len = %d, want 2 after sweep", c.Len())
+	}
+	for _, id := range []int{1000, 2000} {
+		if _, ok := c.Get(id); !ok {
+			t.Errorf("fresh entry %d was swept", id)
+		}
+	}
+}
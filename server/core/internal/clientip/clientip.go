@@ -0,0 +1,33 @@
+// Package clientip 요청을 보낸 클라이언트 IP (동의 이력, 접근 로그, rate limit 키 등)
+package clientip
+
+import (
+	"net"
+	"net/http"
+	"strings"
+)
+
+// Behind 신뢰할 수 있는 프록시 proxies 개(rate_limit.trusted_proxies) 뒤에서 동작할 때 위조할 수 없는 클라이언트 IP
+// X-Forwarded-For 의 첫 번째 값은 클라이언트가 임의로 넣을 수 있으므로 기록용으로도 사용하지 않음
+// 프록시마다 X-Forwarded-For 끝에 직전 주소를 덧붙이므로 끝에서 proxies 번째 값 (0 이면 RemoteAddr)
+func Behind(r *http.Request, proxies int) string {
+	if proxies <= 0 {
+		return remoteHost(r)
+	}
+	var hops []string
+	for _, h := range r.Header.Values("X-Forwarded-For") {
+		hops = append(hops, strings.Split(h, ",")...)
+	}
+	if len(hops) < proxies {
+		return remoteHost(r)
+	}
+	return strings.TrimSpace(hops[len(hops)-proxies])
+}
+
+func remoteHost(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
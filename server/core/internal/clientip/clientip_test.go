@@ -0,0 +1,36 @@
+package clientip
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBehind(t *testing.T) {
+	tests := []struct {
+		name    string
+		xff     []string
+		proxies int
+		want    string
+	}{
+		{name: "no proxy uses remote addr", xff: []string{"198.51.100.1"}, proxies: 0, want: "10.0.0.2"},
+		{name: "one proxy", xff: []string{"203.0.113.9"}, proxies: 1, want: "203.0.113.9"},
+		{name: "spoofed first hop is ignored", xff: []string{"1.2.3.4, 203.0.113.9"}, proxies: 1, want: "203.0.113.9"},
+		{name: "two proxies", xff: []string{"1.2.3.4, 203.0.113.9, 10.0.1.5"}, proxies: 2, want: "203.0.113.9"},
+		{name: "multiple headers", xff: []string{"1.2.3.4", "203.0.113.9"}, proxies: 1, want: "203.0.113.9"},
+		{name: "fewer hops than proxies", xff: []string{"203.0.113.9"}, proxies: 2, want: "10.0.0.2"},
+		{name: "no header", proxies: 1, want: "10.0.0.2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.RemoteAddr = "10.0.0.2:41234"
+			for _, v := range tt.xff {
+				r.Header.Add("X-Forwarded-For", v)
+			}
+			if got := Behind(r, tt.proxies); got != tt.want {
+				t.Errorf("Behind(%v, %d) = %q, want %q", tt.xff, tt.proxies, got, tt.want)
+			}
+		})
+	}
+}
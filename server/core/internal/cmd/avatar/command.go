@@ -0,0 +1,111 @@
+package avatar
+
+import (
+	"context"
+	"server/internal/config"
+	"server/internal/db"
+	"server/internal/flags"
+	"server/internal/repository"
+	"server/internal/service"
+	"server/internal/storage"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagBatchSize = "batch-size"
+	flagDryRun    = "dry-run"
+)
+
+func NewCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "avatar",
+		Usage: "Profile image maintenance jobs",
+		Subcommands: []*cli.Command{
+			newImportProviderCommand(),
+		},
+	}
+}
+
+// newImportProviderCommand OAuth 제공자 URL 을 그대로 쓰고 있는 프로필 이미지를 저장소로 복사 (1회성 작업, 재실행 가능)
+func newImportProviderCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "import-provider",
+		Usage: "Copy provider-hosted profile images (Google/Kakao/Naver) into our blob store",
+		Flags: []cli.Flag{
+			&cli.IntFlag{Name: flagBatchSize, Value: 100, Usage: "users fetched per query"},
+			&cli.BoolFlag{Name: flagDryRun, Usage: "only list target users"},
+		},
+		Action: func(c *cli.Context) error {
+			cfgFile := c.String(flags.FlagConfig)
+			envName := c.String(flags.FlagEnv)
+
+			cfg, err := config.LoadConfig(cfgFile, envName)
+			if err != nil {
+				return errors.Wrapf(err, "[importProvider] LoadConfig failed (cfgFile=%s, env=%s)", cfgFile, envName)
+			}
+			dbCfg, err := cfg.ToDBConfig()
+			if err != nil {
+				return errors.Wrap(err, "[importProvider] ToDBConfig failed")
+			}
+			dbConn, err := db.NewDB(dbCfg.Host, dbCfg.Port, dbCfg.User, dbCfg.Password, dbCfg.DBName, dbCfg.SSLMode)
+			if err != nil {
+				return errors.Wrap(err, "[importProvider] NewDB failed")
+			}
+			defer dbConn.Close()
+
+			blobStore, err := storage.NewBlobStore(cfg)
+			if err != nil {
+				return errors.Wrap(err, "[importProvider] NewBlobStore failed")
+			}
+
+			userRepo := repository.NewPostgresUserRepository(dbConn)
+			avatarService := service.NewAvatarService(cfg, userRepo, blobStore)
+			return importProviderAvatars(c.Context, userRepo, avatarService, c.Int(flagBatchSize), c.Bool(flagDryRun))
+		},
+	}
+}
+
+// importProviderAvatars 실패한 유저는 건너뛰고 계속 진행 (만료된 URL 등), 마지막에 집계 로그
+func importProviderAvatars(
+	ctx context.Context,
+	userRepo repository.UserRepository,
+	avatarService *service.AvatarService,
+	batchSize int,
+	dryRun bool,
+) error {
+	var imported, failed, afterID int
+	for {
+		users, err := userRepo.ListUsersWithProviderAvatar(ctx, afterID, batchSize)
+		if err != nil {
+			return errors.Wrap(err, "[importProviderAvatars] list users failed")
+		}
+		if len(users) == 0 {
+			break
+		}
+
+		for i := range users {
+			u := &users[i]
+			afterID = u.ID
+			if dryRun {
+				log.Info().Int("user_id", u.ID).Str("profile_image", u.ProfileImage).Msg("[importProviderAvatars] target (dry-run)")
+				continue
+			}
+			if _, err := avatarService.ImportProviderAvatar(ctx, u); err != nil {
+				failed++
+				log.Warn().Err(err).Int("user_id", u.ID).Msg("[importProviderAvatars] import failed, skipped")
+				continue
+			}
+			imported++
+		}
+	}
+
+	log.Info().
+		Int("imported", imported).
+		Int("failed", failed).
+		Bool("dry_run", dryRun).
+		Msg("[importProviderAvatars] done")
+	return nil
+}
@@ -0,0 +1,118 @@
+package crashsink
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagPort    = "port"
+	flagVerbose = "verbose"
+)
+
+// NewCommand 로컬 확인용 Sentry 호환 수신 서버
+// crash_report.dsn (또는 CRASH_REPORT_DSN) 을 http://local@localhost:{port}/1 로 두고 실행
+func NewCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "crash-sink",
+		Usage: "Run a local Sentry-compatible endpoint that logs received crash reports",
+		Flags: []cli.Flag{
+			&cli.IntFlag{Name: flagPort, Value: 9010, Usage: "listen port"},
+			&cli.BoolFlag{Name: flagVerbose, Usage: "print the full event JSON"},
+		},
+		Action: func(c *cli.Context) error {
+			port := c.Int(flagPort)
+			mux := http.NewServeMux()
+			mux.Handle("POST /api/{project}/store/", storeHandler(c.Bool(flagVerbose)))
+
+			log.Info().
+				Str("dsn", fmt.Sprintf("http://local@localhost:%d/1", port)).
+				Msg("[crash-sink] listening for crash reports")
+			if err := http.ListenAndServe(fmt.Sprintf(":%d", port), mux); err != nil {
+				return errors.Wrap(err, "[crash-sink] listen failed")
+			}
+			return nil
+		},
+	}
+}
+
+// storeEvent 로그에 남길 필드만 디코딩
+type storeEvent struct {
+	EventID     string            `json:"event_id"`
+	Environment string            `json:"environment"`
+	Fingerprint []string          `json:"fingerprint"`
+	Tags        map[string]string `json:"tags"`
+	Extra       map[string]int    `json:"extra"`
+	Exception   struct {
+		Values []struct {
+			Type       string `json:"type"`
+			Value      string `json:"value"`
+			Stacktrace struct {
+				Frames []struct {
+					Module   string `json:"module"`
+					Function string `json:"function"`
+					Filename string `json:"filename"`
+					Lineno   int    `json:"lineno"`
+					InApp    bool   `json:"in_app"`
+				} `json:"frames"`
+			} `json:"stacktrace"`
+		} `json:"values"`
+	} `json:"exception"`
+}
+
+func storeHandler(verbose bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.Header.Get("X-Sentry-Auth"), "sentry_key=") {
+			http.Error(w, "missing X-Sentry-Auth", http.StatusUnauthorized)
+			return
+		}
+
+		var raw json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&raw); err != nil {
+			http.Error(w, "invalid event", http.StatusBadRequest)
+			return
+		}
+		var ev storeEvent
+		if err := json.Unmarshal(raw, &ev); err != nil {
+			http.Error(w, "invalid event", http.StatusBadRequest)
+			return
+		}
+
+		event := log.Info().
+			Str("project", r.PathValue("project")).
+			Str("event_id", ev.EventID).
+			Str("environment", ev.Environment).
+			Strs("fingerprint", ev.Fingerprint).
+			Str("request_id", ev.Tags["request_id"]).
+			Str("route", ev.Tags["route"]).
+			Int("suppressed_duplicates", ev.Extra["suppressed_duplicates"])
+		if len(ev.Exception.Values) > 0 {
+			exc := ev.Exception.Values[0]
+			event = event.Str("type", exc.Type).Str("value", exc.Value)
+			// 가장 안쪽의 앱 코드 프레임 (frames 는 바깥 호출이 먼저)
+			frames := exc.Stacktrace.Frames
+			for i := len(frames) - 1; i >= 0; i-- {
+				if frames[i].InApp {
+					event = event.Str("at", fmt.Sprintf("%s.%s (%s:%d)",
+						frames[i].Module, frames[i].Function, frames[i].Filename, frames[i].Lineno))
+					break
+				}
+			}
+		}
+		event.Msg("[crash-sink] crash report received")
+
+		if verbose {
+			pretty, _ := json.MarshalIndent(raw, "", "  ")
+			fmt.Fprintln(os.Stdout, string(pretty))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"id":%q}`, ev.EventID)
+	})
+}
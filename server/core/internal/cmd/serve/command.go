@@ -7,13 +7,20 @@ import (
 	"os"
 	"os/signal"
 	"server/internal/config"
+	"server/internal/cookie"
+	"server/internal/crash"
 	"server/internal/db"
 	"server/internal/flags"
 	"server/internal/handler"
+	"server/internal/idempotency"
+	"server/internal/job"
 	"server/internal/middleware"
+	"server/internal/preference"
+	"server/internal/ratelimit"
 	"server/internal/repository"
 	"server/internal/router"
 	"server/internal/service"
+	"server/internal/storage"
 	"syscall"
 	"time"
 
@@ -22,13 +29,21 @@ import (
 	"github.com/urfave/cli/v2"
 )
 
-const shutdownTimeout = 30 * time.Second
+const (
+	shutdownTimeout = 30 * time.Second
+	jobQueueBuffer  = 100
+	jobTimeout      = 10 * time.Minute
+)
 
 type Server struct {
 	httpServer *http.Server
 	db         *db.DB
 	// gRPC, WebSocket, MQ listener...
 	shutdownTimeout time.Duration
+	// 백그라운드 작업(탈퇴 계정 익명화 등) 중지
+	stopBackground context.CancelFunc
+	jobQueue       *job.Queue
+	crashReporter  *crash.Reporter
 }
 
 func NewCommand() *cli.Command {
@@ -79,54 +94,193 @@ func runServer(c *cli.Context) error {
 		return pkgerrors.Wrapf(err, "[runServer] failed to auto-migrate on server start")
 	}
 
+	// 인증 쿠키 속성 (로그인, 토큰 재발급, 로그아웃 공통)
+	cookies, err := cookie.NewPolicy(cfg)
+	if err != nil {
+		return pkgerrors.Wrap(err, "[runServer] cookie.NewPolicy failed")
+	}
+
 	// 리포지토리 & 서비스
 	userRepo := repository.NewPostgresUserRepository(dbConn)
 	refreshTokenRepo := repository.NewPostgresRefreshTokenRepo(dbConn)
+	identityRepo := repository.NewPostgresUserIdentityRepository(dbConn)
+	dataExportRepo := repository.NewPostgresDataExportRepository(dbConn)
+	consentRepo := repository.NewPostgresConsentRepository(dbConn)
+	preferenceRepo := repository.NewPostgresPreferenceRepository(dbConn)
+	adminRepo := repository.NewPostgresAdminRepository(dbConn)
+	activityRepo := repository.NewPostgresActivityRepository(dbConn)
+	followRepo := repository.NewPostgresFollowRepository(dbConn)
+	blockRepo := repository.NewPostgresBlockRepository(dbConn)
+	notificationRepo := repository.NewPostgresNotificationRepository(dbConn)
+	handleRepo := repository.NewPostgresHandleRepository(dbConn)
 	jwtManager := service.NewJWTManager()
 
+	// 업로드 파일 저장소
+	blobStore, err := storage.NewBlobStore(cfg)
+	if err != nil {
+		return pkgerrors.Wrap(err, "[runServer] NewBlobStore failed")
+	}
+
+	activityService := service.NewActivityService(userRepo, activityRepo)
+	preferenceService := service.NewPreferenceService(preference.NewDefaultRegistry(), preferenceRepo)
+	blockService := service.NewBlockService(userRepo, blockRepo)
+	notificationService := service.NewNotificationService(notificationRepo, preferenceService, blockService)
+	adminService := service.NewAdminService(userRepo, adminRepo, refreshTokenRepo)
 	authService := service.NewAuthService(
 		cfg,
+		cookies,
 		oAuthSecrets,
 		userRepo,
 		refreshTokenRepo,
+		identityRepo,
 		jwtManager,
+		activityService,
+		adminService,
+		notificationService,
 	)
-	userService := service.NewUserService(userRepo)
+	userService := service.NewUserService(userRepo, preferenceService)
+	avatarService := service.NewAvatarService(cfg, userRepo, blobStore)
+	accountService := service.NewAccountService(
+		cfg,
+		userRepo,
+		refreshTokenRepo,
+		identityRepo,
+		service.NewOAuthUnlinker(oAuthSecrets),
+		avatarService,
+		adminService,
+	)
+
+	// 백그라운드 작업 큐 (개인정보 내보내기 등)
+	jobQueue := job.NewQueue(cfg.DataExport.Workers, jobQueueBuffer, jobTimeout)
+	dataExportService := service.NewDataExportService(
+		cfg,
+		dataExportRepo,
+		userRepo,
+		identityRepo,
+		refreshTokenRepo,
+		jobQueue,
+		notificationService,
+		blobStore,
+	)
+	consentService := service.NewConsentService(consentRepo)
+	dataExportService.RegisterSection(consentService.ExportSection())
+	dataExportService.RegisterSection(preferenceService.ExportSection())
+	dataExportService.RegisterSection(blockService.ExportSection())
+	followService := service.NewFollowService(userRepo, followRepo, preferenceService, blockService, notificationService)
+	dataExportService.RegisterSection(followService.ExportSection())
+	dataExportService.RegisterSection(notificationService.ExportSection())
+	dataExportService.RegisterSection(notificationService.SecurityExportSection())
+	dataExportService.RegisterSection(activityService.ExportSection())
+	dataExportService.RegisterSection(adminService.ExportSection())
+	profileService := service.NewProfileService(handleRepo, preferenceService, blockService, followService)
+	dataExportService.RegisterSection(profileService.ExportSection())
+
+	// panic 크래시 리포트 (DSN 이 없으면 nil)
+	crashReporter, err := crash.NewReporterFromConfig(cfg, envName)
+	if err != nil {
+		return pkgerrors.Wrap(err, "[runServer] NewReporterFromConfig failed")
+	}
+
+	// 요청 빈도 제한 (rate_limit.enabled=false 면 nil)
+	limiter, err := ratelimit.NewLimiterFromConfig(cfg, dbConn)
+	if err != nil {
+		return pkgerrors.Wrap(err, "[runServer] NewLimiterFromConfig failed")
+	}
 
 	// 미들웨어
-	authMw := middleware.NewAuthMiddleware(jwtManager, refreshTokenRepo, userRepo)
-	corsMw := middleware.NewCORSMiddleware(cfg)
+	rateLimitMw := middleware.NewRateLimitMiddleware(limiter, cfg.RateLimit.TrustedProxies)
+	idempotencyStore := idempotency.NewPostgresStore(dbConn)
+	idempotencyMw := middleware.NewIdempotencyMiddleware(idempotencyStore, cfg)
+	authMw := middleware.NewAuthMiddleware(jwtManager, refreshTokenRepo, userRepo, consentService, adminService, activityService, rateLimitMw, cookies)
+	corsMw, err := middleware.NewCORSMiddleware(cfg)
+	if err != nil {
+		return pkgerrors.Wrap(err, "[runServer] NewCORSMiddleware failed")
+	}
+	accessLogMw := middleware.NewAccessLogMiddleware(cfg.RateLimit.TrustedProxies)
+	securityHeadersMw := middleware.NewSecurityHeadersMiddleware(cfg)
+	recoverMw := middleware.NewRecoverMiddleware(crashReporter)
 
 	// 핸들러
-	authHandler := handler.NewAuthHandler(cfg, authService)
-	userHandler := handler.NewUserHandler(userService)
+	authHandler := handler.NewAuthHandler(cfg, cookies, authService)
+	userHandler := handler.NewUserHandler(userService, activityService, followService)
+	accountHandler := handler.NewAccountHandler(cfg, cookies, accountService)
+	dataExportHandler := handler.NewDataExportHandler(cfg, dataExportService)
+	consentHandler := handler.NewConsentHandler(cfg, consentService)
+	avatarHandler := handler.NewAvatarHandler(cfg, avatarService)
+	mediaHandler := handler.NewMediaHandler(blobStore)
+	preferenceHandler := handler.NewPreferenceHandler(preferenceService)
+	adminHandler := handler.NewAdminHandler(adminService, activityService)
+	followHandler := handler.NewFollowHandler(followService)
+	blockHandler := handler.NewBlockHandler(blockService)
+	notificationHandler := handler.NewNotificationHandler(notificationService)
+	profileHandler := handler.NewProfileHandler(profileService, userService)
 	healthHandler := handler.NewHealthHandler()
 
 	// 라우터
 	rCfg := router.Config{
-		AuthHandler:    authHandler,
-		HealthHandler:  healthHandler,
-		UserHandler:    userHandler,
-		AuthMiddleware: authMw,
+		AuthHandler:           authHandler,
+		HealthHandler:         healthHandler,
+		UserHandler:           userHandler,
+		AccountHandler:        accountHandler,
+		DataExportHandler:     dataExportHandler,
+		ConsentHandler:        consentHandler,
+		AvatarHandler:         avatarHandler,
+		MediaHandler:          mediaHandler,
+		PreferenceHandler:     preferenceHandler,
+		AdminHandler:          adminHandler,
+		FollowHandler:         followHandler,
+		BlockHandler:          blockHandler,
+		NotificationHandler:   notificationHandler,
+		ProfileHandler:        profileHandler,
+		AuthMiddleware:        authMw,
+		RateLimitMiddleware:   rateLimitMw,
+		IdempotencyMiddleware: idempotencyMw,
+		Cookies:               cookies,
+		EnableDocs:            envName != flags.EnvProd,
 	}
 	mux := router.NewRouter(rCfg)
+	for _, route := range mux.Routes() {
+		log.Debug().
+			Str("method", route.Method).
+			Str("pattern", route.Pattern).
+			Str("handler", route.Handler).
+			Strs("middlewares", route.Middlewares).
+			Msg("[runServer] route registered")
+	}
 
-	// CORS 래핑
-	corsWrapped := corsMw(mux)
+	// 요청 ID/접근 로그 → 보안 헤더 → panic 복구 → CORS → 라우터 순서로 래핑
+	// (preflight 요청도 접근 로그에 남고, panic 으로 인한 500 응답에도 보안 헤더가 붙음)
+	rootHandler := accessLogMw(securityHeadersMw(recoverMw(corsMw(mux))))
 
 	// HTTP 서버 생성
 	httpSrv := config.NewServer(
 		cfg.Server.Port,
-		corsWrapped,
+		rootHandler,
 		cfg.ServerReadTimeout(),
 		cfg.ServerWriteTimeout(),
 	)
 
+	// 백그라운드 작업
+	bgCtx, stopBackground := context.WithCancel(context.Background())
+	go accountService.RunPurgeLoop(bgCtx)
+	jobQueue.Start(bgCtx)
+	go dataExportService.RunCleanupLoop(bgCtx) // 시작 시 중단된 내보내기 요청도 재개
+	if limiter != nil {
+		go limiter.RunCleanupLoop(bgCtx)
+	}
+	go idempotency.RunCleanupLoop(bgCtx, idempotencyStore)
+	if crashReporter != nil {
+		crashReporter.Start()
+	}
+
 	// Server 구조체 초기화
 	srv := &Server{
 		httpServer:      httpSrv,
 		db:              dbConn,
 		shutdownTimeout: shutdownTimeout,
+		stopBackground:  stopBackground,
+		jobQueue:        jobQueue,
+		crashReporter:   crashReporter,
 	}
 
 	// 종료 처리
@@ -175,11 +329,26 @@ func (s *Server) gracefulShutdown() error {
 
 	log.Info().Msg("[gracefulShutdown] Initiating graceful shutdown...")
 
+	// 백그라운드 작업 중지
+	if s.stopBackground != nil {
+		s.stopBackground()
+	}
+	if s.jobQueue != nil {
+		s.jobQueue.Stop()
+		log.Info().Msg("[gracefulShutdown] Job queue stopped")
+	}
+
 	// HTTP 서버 종료 (리스너 즉시 닫아 새로운 요청 즉시 차단, 이미 진행 중인 요청은 계속 처리)
 	if err := s.httpServer.Shutdown(ctx); err != nil {
 		return pkgerrors.Wrap(err, "[gracefulShutdown] failed to shutdown HTTP server")
 	}
 
+	// 진행 중인 요청까지 끝난 뒤 남은 크래시 리포트 전송
+	if s.crashReporter != nil {
+		s.crashReporter.Stop()
+		log.Info().Msg("[gracefulShutdown] Crash reporter stopped")
+	}
+
 	// DB 커넥션 종료
 	if s.db != nil {
 		s.db.Close()
@@ -27,11 +27,105 @@ type AppConfig struct {
 	// 쿠키 Domain (local, dev, prod 별로 상이)
 	CookieDomain string `koanf:"cookie_domain"`
 
+	// 인증 쿠키 속성 (cookie.Policy)
+	Cookie struct {
+		Prefix      string `koanf:"prefix"`    // none | secure (__Secure-) | host (__Host-, cookie_domain 무시)
+		Secure      string `koanf:"secure"`    // auto (backend_base_url 이 https 면) | always | never
+		SameSite    string `koanf:"same_site"` // lax | strict | none
+		Partitioned bool   `koanf:"partitioned"`
+	} `koanf:"cookie"`
+
 	// 환경별 Backend/Frontend Base URL
 	Endpoints struct {
 		BackendBaseURL  string `koanf:"backend_base_url"`
 		FrontendBaseURL string `koanf:"frontend_base_url"`
 	} `koanf:"endpoints"`
+
+	// 회원 탈퇴 (soft delete 유예 기간, 익명화 작업 주기)
+	Account struct {
+		DeletionGracePeriodDays int `koanf:"deletion_grace_period_days"`
+		PurgeIntervalMinutes    int `koanf:"purge_interval_minutes"`
+	} `koanf:"account"`
+
+	// 개인정보 내보내기 (다운로드 링크 유효 시간, 워커 수), ZIP 은 storage 의 private/exports/ 아래 저장
+	DataExport struct {
+		DownloadTTLHours int `koanf:"download_ttl_hours"`
+		Workers          int `koanf:"workers"`
+	} `koanf:"data_export"`
+
+	// 업로드 파일 저장소 (local: 파일시스템, s3: S3 호환 저장소)
+	// S3 access key / secret key 는 환경 변수(STORAGE_S3_ACCESS_KEY, STORAGE_S3_SECRET_KEY)로만 주입
+	Storage struct {
+		Driver        string `koanf:"driver"`
+		LocalDir      string `koanf:"local_dir"`
+		PublicBaseURL string `koanf:"public_base_url"`
+		S3            struct {
+			Endpoint     string `koanf:"endpoint"`
+			Region       string `koanf:"region"`
+			Bucket       string `koanf:"bucket"`
+			UsePathStyle bool   `koanf:"use_path_style"`
+		} `koanf:"s3"`
+	} `koanf:"storage"`
+
+	// 프로필 이미지 업로드 제한
+	Avatar struct {
+		MaxUploadBytes int64 `koanf:"max_upload_bytes"`
+		MaxPixels      int   `koanf:"max_pixels"`
+	} `koanf:"avatar"`
+
+	// 요청 처리 중 panic 리포트 전송 (DSN 이 비어 있으면 로그만 남김)
+	CrashReport struct {
+		DSN                string `koanf:"dsn"`
+		DedupWindowSeconds int    `koanf:"dedup_window_seconds"`
+		MaxPerMinute       int    `koanf:"max_per_minute"`
+		QueueSize          int    `koanf:"queue_size"`
+	} `koanf:"crash_report"`
+
+	// 보안 응답 헤더 (비어 있거나 0 이면 해당 헤더 미전송, X-Content-Type-Options 는 항상)
+	SecurityHeaders struct {
+		HSTSMaxAgeSeconds     int    `koanf:"hsts_max_age_seconds"`
+		HSTSIncludeSubdomains bool   `koanf:"hsts_include_subdomains"`
+		HSTSPreload           bool   `koanf:"hsts_preload"`
+		FrameOptions          string `koanf:"frame_options"`
+		ReferrerPolicy        string `koanf:"referrer_policy"`
+		ContentSecurityPolicy string `koanf:"content_security_policy"`
+	} `koanf:"security_headers"`
+
+	// CORS (endpoints 의 frontend/backend 주소는 항상 허용)
+	CORS struct {
+		// 정확한 Origin 또는 서브도메인 한 단계 와일드카드 (https://*.dev.step-journey.com)
+		AllowedOrigins   []string `koanf:"allowed_origins"`
+		AllowedMethods   []string `koanf:"allowed_methods"`
+		AllowedHeaders   []string `koanf:"allowed_headers"`
+		ExposedHeaders   []string `koanf:"exposed_headers"`
+		MaxAgeSeconds    int      `koanf:"max_age_seconds"`
+		AllowCredentials bool     `koanf:"allow_credentials"`
+	} `koanf:"cors"`
+
+	// 요청 빈도 제한 (정책 이름은 router.setupRoutes 에서 라우트 그룹에 지정)
+	RateLimit struct {
+		Enabled bool   `koanf:"enabled"`
+		Backend string `koanf:"backend"` // memory | postgres
+		// X-Forwarded-For 를 덧붙이는 신뢰할 수 있는 프록시 수 (ALB 뒤면 1, 직접 노출이면 0)
+		// rate limit 키 외에 접근 로그, 동의 이력, Idempotency-Key 범위의 클라이언트 IP 에도 사용
+		TrustedProxies int                        `koanf:"trusted_proxies"`
+		Policies       map[string]RateLimitPolicy `koanf:"policies"`
+	} `koanf:"rate_limit"`
+
+	// Idempotency-Key 헤더로 재시도한 요청에 처음 응답을 재사용 (router 에서 Idempotent 로 지정한 라우트)
+	Idempotency struct {
+		TTLHours int `koanf:"ttl_hours"` // 같은 key 의 응답을 보관하는 기간
+		// 처리 중 표시 유지 시간 (인스턴스가 응답 전에 죽으면 이 시간 뒤 같은 key 로 다시 처리)
+		LockTimeoutSeconds int   `koanf:"lock_timeout_seconds"`
+		MaxBodyBytes       int64 `koanf:"max_body_bytes"` // fingerprint 계산을 위해 미리 읽는 요청 본문 최대 크기
+	} `koanf:"idempotency"`
+}
+
+// RateLimitPolicy 토큰 버킷 정책 (분당 충전량, 버킷 크기, 버킷 키 기준 ip|user)
+type RateLimitPolicy struct {
+	RatePerMinute float64 `koanf:"rate_per_minute"`
+	Burst         int     `koanf:"burst"`
+	Key           string  `koanf:"key"`
 }
 
 type DBConfig struct {
@@ -166,3 +260,45 @@ func (c *AppConfig) ServerReadTimeout() time.Duration {
 func (c *AppConfig) ServerWriteTimeout() time.Duration {
 	return time.Duration(c.Server.WriteTimeoutSeconds) * time.Second
 }
+
+func (c *AppConfig) AccountDeletionGracePeriod() time.Duration {
+	return time.Duration(c.Account.DeletionGracePeriodDays) * 24 * time.Hour
+}
+func (c *AppConfig) AccountPurgeInterval() time.Duration {
+	return time.Duration(c.Account.PurgeIntervalMinutes) * time.Minute
+}
+func (c *AppConfig) DataExportDownloadTTL() time.Duration {
+	return time.Duration(c.DataExport.DownloadTTLHours) * time.Hour
+}
+
+// StoragePublicBaseURL 공개 URL 접두사 (local 드라이버에서 비어 있으면 미디어 서빙 API 주소)
+func (c *AppConfig) StoragePublicBaseURL() string {
+	if c.Storage.PublicBaseURL != "" {
+		return c.Storage.PublicBaseURL
+	}
+	return c.Endpoints.BackendBaseURL + "/api/v1/media"
+}
+func (c *AppConfig) StorageS3AccessKey() string {
+	return os.Getenv(flags.EnvKeyStorageS3AccessKey)
+}
+func (c *AppConfig) StorageS3SecretKey() string {
+	return os.Getenv(flags.EnvKeyStorageS3SecretKey)
+}
+
+// CrashReportDSN 환경 변수(시크릿)가 있으면 설정 파일 값보다 우선
+func (c *AppConfig) CrashReportDSN() string {
+	if dsn := os.Getenv(flags.EnvKeyCrashReportDSN); dsn != "" {
+		return dsn
+	}
+	return c.CrashReport.DSN
+}
+func (c *AppConfig) CrashReportDedupWindow() time.Duration {
+	return time.Duration(c.CrashReport.DedupWindowSeconds) * time.Second
+}
+
+func (c *AppConfig) IdempotencyTTL() time.Duration {
+	return time.Duration(c.Idempotency.TTLHours) * time.Hour
+}
+func (c *AppConfig) IdempotencyLockTimeout() time.Duration {
+	return time.Duration(c.Idempotency.LockTimeoutSeconds) * time.Second
+}
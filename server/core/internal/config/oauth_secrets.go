@@ -21,6 +21,7 @@ type OAuthSecrets struct {
 
 	KakaoRestApiKey   string `json:"kakao_rest_api_key"`
 	KakaoClientSecret string `json:"kakao_client_secret"`
+	KakaoAdminKey     string `json:"kakao_admin_key"` // 회원 탈퇴 시 연결 끊기(unlink) API 호출용
 }
 
 var (
@@ -50,6 +51,7 @@ func loadAllOAuthSecrets() (*OAuthSecrets, error) {
 
 		kakaoRestApiKey := os.Getenv(flags.EnvKeyOauthKakaoRestApiKey)
 		kakaoSecret := os.Getenv(flags.EnvKeyOauthKakaoClientSecret)
+		kakaoAdminKey := os.Getenv(flags.EnvKeyOauthKakaoAdminKey)
 
 		log.Debug().
 			Str("google_client_id", googleID).
@@ -64,6 +66,7 @@ func loadAllOAuthSecrets() (*OAuthSecrets, error) {
 			NaverClientSecret:  naverSecret,
 			KakaoRestApiKey:    kakaoRestApiKey,
 			KakaoClientSecret:  kakaoSecret,
+			KakaoAdminKey:      kakaoAdminKey,
 		}, nil
 	}
 
@@ -0,0 +1,141 @@
+// Package cookie 인증 쿠키 발급/삭제/조회 공통 처리 (로그인, 토큰 재발급, 로그아웃이 같은 속성을 쓰도록)
+package cookie
+
+import (
+	"net/http"
+	"net/url"
+	"server/internal/config"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// 인증 쿠키 이름 (prefix 적용 전)
+const (
+	AccessToken  = "access_token"
+	RefreshToken = "refresh_token"
+	RestoreToken = "restore_token"
+)
+
+const (
+	PrefixNone   = "none"
+	PrefixSecure = "secure" // __Secure-: Secure 필수
+	PrefixHost   = "host"   // __Host-: Secure + Path=/ + Domain 없음 (현재 호스트 전용)
+
+	SecureAuto   = "auto" // endpoints.backend_base_url 이 https 면 Secure
+	SecureAlways = "always"
+	SecureNever  = "never"
+)
+
+// Policy cookie 설정으로 정한 쿠키 속성
+type Policy struct {
+	prefix       string
+	domain       string
+	legacyDomain string // prefix 도입 전 쿠키를 발급한 domain (삭제용)
+	secure       bool
+	sameSite     http.SameSite
+	partitioned  bool
+}
+
+func NewPolicy(cfg *config.AppConfig) (*Policy, error) {
+	c := cfg.Cookie
+	p := &Policy{domain: cfg.CookieDomain, legacyDomain: cfg.CookieDomain, partitioned: c.Partitioned}
+
+	switch c.Secure {
+	case "", SecureAuto:
+		u, err := url.Parse(cfg.Endpoints.BackendBaseURL)
+		if err != nil {
+			return nil, errors.Wrap(err, "[NewPolicy] parse backend_base_url failed")
+		}
+		p.secure = u.Scheme == "https"
+	case SecureAlways:
+		p.secure = true
+	case SecureNever:
+	default:
+		return nil, errors.Errorf("[NewPolicy] unknown cookie.secure: %s", c.Secure)
+	}
+
+	switch strings.ToLower(c.SameSite) {
+	case "", "lax":
+		p.sameSite = http.SameSiteLaxMode
+	case "strict":
+		p.sameSite = http.SameSiteStrictMode
+	case "none":
+		p.sameSite = http.SameSiteNoneMode
+	default:
+		return nil, errors.Errorf("[NewPolicy] unknown cookie.same_site: %s", c.SameSite)
+	}
+
+	switch c.Prefix {
+	case "", PrefixNone:
+	case PrefixSecure:
+		p.prefix = "__Secure-"
+	case PrefixHost:
+		// __Host- 쿠키는 Domain 을 지정할 수 없음 (cookie_domain 은 이전 쿠키 삭제에만 사용)
+		p.prefix = "__Host-"
+		p.domain = ""
+	default:
+		return nil, errors.Errorf("[NewPolicy] unknown cookie.prefix: %s", c.Prefix)
+	}
+
+	// 브라우저는 아래 조합의 쿠키를 Secure 없이 받지 않음
+	if !p.secure && (p.prefix != "" || p.sameSite == http.SameSiteNoneMode || p.partitioned) {
+		return nil, errors.New("[NewPolicy] cookie prefix, same_site=none and partitioned require secure cookies")
+	}
+	return p, nil
+}
+
+// Name prefix 를 붙인 실제 쿠키 이름
+func (p *Policy) Name(name string) string {
+	return p.prefix + name
+}
+
+// Set HttpOnly 쿠키 발급 (ttl 동안 유효)
+func (p *Policy) Set(w http.ResponseWriter, name, value string, ttl time.Duration) {
+	http.SetCookie(w, p.cookie(p.Name(name), value, ttl))
+}
+
+// Clear 쿠키 삭제 (prefix 도입 전에 발급된 이름의 쿠키도 함께 삭제)
+func (p *Policy) Clear(w http.ResponseWriter, names ...string) {
+	for _, name := range names {
+		http.SetCookie(w, p.cookie(p.Name(name), "", -1))
+		if p.prefix != "" {
+			legacy := p.cookie(name, "", -1)
+			legacy.Domain = p.legacyDomain
+			legacy.Partitioned = false
+			http.SetCookie(w, legacy)
+		}
+	}
+}
+
+// Value 요청의 쿠키 값 (prefix 가 붙은 이름만 인정, prefix 없이 발급된 예전 쿠키는 하위 도메인에서 심을 수 있으므로 무시)
+func (p *Policy) Value(r *http.Request, name string) (string, bool) {
+	c, err := r.Cookie(p.Name(name))
+	if err != nil {
+		return "", false
+	}
+	return c.Value, true
+}
+
+// cookie ttl < 0 이면 즉시 만료
+func (p *Policy) cookie(name, value string, ttl time.Duration) *http.Cookie {
+	c := &http.Cookie{
+		Name:        name,
+		Value:       value,
+		Path:        "/",
+		Domain:      p.domain,
+		Secure:      p.secure,
+		HttpOnly:    true,
+		SameSite:    p.sameSite,
+		Partitioned: p.partitioned,
+	}
+	if ttl < 0 {
+		c.Expires = time.Unix(0, 0)
+		c.MaxAge = -1
+	} else {
+		c.Expires = time.Now().Add(ttl)
+		c.MaxAge = int(ttl.Seconds())
+	}
+	return c
+}
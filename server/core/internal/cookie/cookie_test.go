@@ -0,0 +1,45 @@
+package cookie
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"server/internal/config"
+	"testing"
+)
+
+func TestPolicyValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		prefix  string
+		cookies map[string]string
+		want    string
+		wantOK  bool
+	}{
+		{"no prefix", PrefixNone, map[string]string{"refresh_token": "plain"}, "plain", true},
+		{"host prefix", PrefixHost, map[string]string{"__Host-refresh_token": "host"}, "host", true},
+		{"host prefix wins over unprefixed", PrefixHost, map[string]string{"refresh_token": "plain", "__Host-refresh_token": "host"}, "host", true},
+		{"unprefixed cookie ignored under host prefix", PrefixHost, map[string]string{"refresh_token": "plain"}, "", false},
+		{"unprefixed cookie ignored under secure prefix", PrefixSecure, map[string]string{"refresh_token": "plain"}, "", false},
+		{"missing", PrefixSecure, nil, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.AppConfig{}
+			cfg.Endpoints.BackendBaseURL = "https://api.step-journey.com"
+			cfg.Cookie.Prefix = tt.prefix
+			p, err := NewPolicy(cfg)
+			if err != nil {
+				t.Fatalf("NewPolicy: %v", err)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/reissue", nil)
+			for name, value := range tt.cookies {
+				req.AddCookie(&http.Cookie{Name: name, Value: value})
+			}
+			got, ok := p.Value(req, RefreshToken)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("Value = (%q, %v), want (%q, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package crash
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// 지문 계산에 사용하는 최상단 스택 프레임 수
+const fingerprintFrames = 5
+
+// Frame 스택 프레임 하나 (가장 안쪽 호출이 먼저)
+type Frame struct {
+	Function string `json:"function"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+}
+
+// Report 요청 처리 중 발생한 panic 1건
+type Report struct {
+	Time      time.Time
+	RequestID string
+	Method    string
+	Path      string
+	Route     string
+	PanicType string
+	Panic     string
+	Frames    []Frame
+	// 같은 지문의 panic 은 하나로 묶어서 전송 (중복 제거 단위)
+	Fingerprint string
+	// 직전 전송 이후 중복 제거/속도 제한으로 생략된 같은 지문의 panic 수
+	Suppressed int
+}
+
+// Sink 크래시 리포트 전송 대상 (Sentry 호환 엔드포인트 등)
+type Sink interface {
+	Send(ctx context.Context, report Report) error
+}
+
+// NewReport recover 한 값으로 리포트 생성
+// skip: NewReport 를 호출한 함수부터 건너뛸 프레임 수 (defer 함수에서 호출하면 1)
+func NewReport(rec interface{}, skip int) Report {
+	frames := callers(skip + 1)
+	report := Report{
+		Time:      time.Now().UTC(),
+		PanicType: fmt.Sprintf("%T", rec),
+		Panic:     fmt.Sprint(rec),
+		Frames:    frames,
+	}
+	if err, ok := rec.(error); ok {
+		report.Panic = err.Error()
+	}
+	report.Fingerprint = fingerprint(report.PanicType, frames)
+	return report
+}
+
+// callers panic 이 발생한 지점부터의 프레임 (runtime 내부 프레임 제외)
+// skip 0 이면 callers 를 호출한 함수부터
+func callers(skip int) []Frame {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(skip+2, pcs)
+	iter := runtime.CallersFrames(pcs[:n])
+
+	var frames []Frame
+	for {
+		f, more := iter.Next()
+		if !strings.HasPrefix(f.Function, "runtime.") {
+			frames = append(frames, Frame{Function: f.Function, File: f.File, Line: f.Line})
+		}
+		if !more {
+			break
+		}
+	}
+	return frames
+}
+
+// fingerprint panic 타입 + 최상단 프레임 위치로 계산
+// 메시지는 인덱스 값 등 요청마다 달라질 수 있어 제외
+func fingerprint(panicType string, frames []Frame) string {
+	h := sha256.New()
+	h.Write([]byte(panicType))
+	for i, f := range frames {
+		if i == fingerprintFrames {
+			break
+		}
+		fmt.Fprintf(h, "\n%s:%d", f.Function, f.Line)
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
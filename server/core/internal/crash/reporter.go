@@ -0,0 +1,162 @@
+package crash
+
+import (
+	"context"
+	"server/internal/config"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const sendTimeout = 5 * time.Second
+
+// Options 중복 제거 / 속도 제한 설정
+type Options struct {
+	// 같은 지문의 panic 은 이 기간 동안 한 번만 전송
+	DedupWindow time.Duration
+	// 1분 동안 전송할 최대 리포트 수 (0 이면 제한 없음)
+	MaxPerMinute int
+	// 전송 대기열 크기 (가득 차면 버림)
+	QueueSize int
+}
+
+// Reporter panic 리포트를 백그라운드에서 Sink 로 전송
+// 요청 처리 고루틴은 대기열에 넣기만 하고 바로 반환 (전송 지연/실패가 응답에 영향 없음)
+type Reporter struct {
+	sink    Sink
+	opts    Options
+	reports chan Report
+
+	mu          sync.Mutex
+	lastSent    map[string]time.Time // 지문 → 마지막 전송 시각
+	suppressed  map[string]int       // 지문 → 마지막 전송 이후 생략된 수
+	windowStart time.Time
+	windowCount int
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewReporter(sink Sink, opts Options) *Reporter {
+	if opts.QueueSize <= 0 {
+		opts.QueueSize = 100
+	}
+	return &Reporter{
+		sink:       sink,
+		opts:       opts,
+		reports:    make(chan Report, opts.QueueSize),
+		lastSent:   make(map[string]time.Time),
+		suppressed: make(map[string]int),
+		stop:       make(chan struct{}),
+	}
+}
+
+// Capture 리포트를 전송 대기열에 추가 (중복/속도 제한/대기열 초과로 버리면 false)
+func (rp *Reporter) Capture(report Report) bool {
+	if !rp.allow(&report) {
+		return false
+	}
+	select {
+	case rp.reports <- report:
+		return true
+	default:
+		log.Warn().Str("fingerprint", report.Fingerprint).Msg("[Reporter.Capture] crash report queue is full, report dropped")
+		return false
+	}
+}
+
+// allow 같은 지문이 DedupWindow 안에 이미 전송됐거나 1분 전송 한도를 넘으면 생략 (생략 수는 다음 전송에 포함)
+func (rp *Reporter) allow(report *Report) bool {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+
+	now := report.Time
+	if last, ok := rp.lastSent[report.Fingerprint]; ok && now.Sub(last) < rp.opts.DedupWindow {
+		rp.suppressed[report.Fingerprint]++
+		return false
+	}
+
+	if now.Sub(rp.windowStart) >= time.Minute {
+		rp.windowStart = now
+		rp.windowCount = 0
+	}
+	if rp.opts.MaxPerMinute > 0 && rp.windowCount >= rp.opts.MaxPerMinute {
+		rp.suppressed[report.Fingerprint]++
+		return false
+	}
+	rp.windowCount++
+
+	rp.lastSent[report.Fingerprint] = now
+	report.Suppressed = rp.suppressed[report.Fingerprint]
+	delete(rp.suppressed, report.Fingerprint)
+
+	// 오래된 지문 정리 (서로 다른 panic 이 계속 쌓이지 않게)
+	for fp, last := range rp.lastSent {
+		if now.Sub(last) >= rp.opts.DedupWindow && rp.suppressed[fp] == 0 {
+			delete(rp.lastSent, fp)
+		}
+	}
+	return true
+}
+
+// Start 전송 워커 시작
+func (rp *Reporter) Start() {
+	rp.wg.Add(1)
+	go rp.work()
+}
+
+// Stop 대기열에 남은 리포트를 전송한 뒤 워커 종료
+func (rp *Reporter) Stop() {
+	close(rp.stop)
+	rp.wg.Wait()
+}
+
+func (rp *Reporter) work() {
+	defer rp.wg.Done()
+	for {
+		select {
+		case report := <-rp.reports:
+			rp.send(report)
+		case <-rp.stop:
+			for {
+				select {
+				case report := <-rp.reports:
+					rp.send(report)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (rp *Reporter) send(report Report) {
+	ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+	defer cancel()
+
+	if err := rp.sink.Send(ctx, report); err != nil {
+		log.Error().Err(err).
+			Str("request_id", report.RequestID).
+			Str("fingerprint", report.Fingerprint).
+			Msg("[Reporter.send] send crash report failed")
+	}
+}
+
+// NewReporterFromConfig crash_report 설정으로 Reporter 생성 (DSN 이 없으면 nil → 로그만 남김)
+func NewReporterFromConfig(cfg *config.AppConfig, envName string) (*Reporter, error) {
+	dsn := cfg.CrashReportDSN()
+	if dsn == "" {
+		return nil, nil
+	}
+	sink, err := NewSentrySink(dsn, envName)
+	if err != nil {
+		return nil, errors.Wrap(err, "[NewReporterFromConfig] create sentry sink failed")
+	}
+	return NewReporter(sink, Options{
+		DedupWindow:  cfg.CrashReportDedupWindow(),
+		MaxPerMinute: cfg.CrashReport.MaxPerMinute,
+		QueueSize:    cfg.CrashReport.QueueSize,
+	}), nil
+}
@@ -0,0 +1,147 @@
+package crash
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestReporterAllow(t *testing.T) {
+	type step struct {
+		fingerprint    string
+		at             time.Duration // 기준 시각으로부터
+		wantAllowed    bool
+		wantSuppressed int // 허용된 경우 리포트에 실린 생략 수
+	}
+	tests := []struct {
+		name  string
+		opts  Options
+		steps []step
+	}{
+		{
+			name: "same fingerprint within dedup window is suppressed",
+			opts: Options{DedupWindow: time.Minute},
+			steps: []step{
+				{"a", 0, true, 0},
+				{"a", 10 * time.Second, false, 0},
+				{"a", 59 * time.Second, false, 0},
+				{"a", 61 * time.Second, true, 2},
+				{"a", 62 * time.Second, false, 0},
+			},
+		},
+		{
+			name: "different fingerprints are deduplicated separately",
+			opts: Options{DedupWindow: time.Minute},
+			steps: []step{
+				{"a", 0, true, 0},
+				{"b", time.Second, true, 0},
+				{"a", 2 * time.Second, false, 0},
+				{"b", 61 * time.Second, true, 0},
+				{"a", 62 * time.Second, true, 1},
+			},
+		},
+		{
+			name: "per-minute limit across fingerprints",
+			opts: Options{DedupWindow: time.Second, MaxPerMinute: 2},
+			steps: []step{
+				{"a", 0, true, 0},
+				{"b", time.Second, true, 0},
+				{"c", 2 * time.Second, false, 0},
+				{"c", 30 * time.Second, false, 0},
+				{"c", 60 * time.Second, true, 2},
+				{"d", 61 * time.Second, true, 0},
+				{"e", 62 * time.Second, false, 0},
+			},
+		},
+		{
+			name: "zero limit means unlimited",
+			opts: Options{DedupWindow: time.Second},
+			steps: []step{
+				{"a", 0, true, 0},
+				{"b", 0, true, 0},
+				{"c", 0, true, 0},
+				{"d", 0, true, 0},
+			},
+		},
+	}
+
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rp := NewReporter(nil, tt.opts)
+			for i, s := range tt.steps {
+				report := Report{Fingerprint: s.fingerprint, Time: base.Add(s.at)}
+				allowed := rp.allow(&report)
+				if allowed != s.wantAllowed {
+					t.Fatalf("step %d (%s at %v): allowed = %v, want %v", i, s.fingerprint, s.at, allowed, s.wantAllowed)
+				}
+				if allowed && report.Suppressed != s.wantSuppressed {
+					t.Errorf("step %d (%s at %v): suppressed = %d, want %d", i, s.fingerprint, s.at, report.Suppressed, s.wantSuppressed)
+				}
+			}
+		})
+	}
+}
+
+// TestReporterAllowPrunesFingerprints 오래된 지문은 정리하되 생략 수가 남은 지문은 다음 전송까지 유지
+func TestReporterAllowPrunesFingerprints(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	rp := NewReporter(nil, Options{DedupWindow: time.Minute})
+	for _, r := range []Report{
+		{Fingerprint: "a", Time: base},
+		{Fingerprint: "a", Time: base.Add(time.Second)}, // 생략 → suppressed[a]=1
+		{Fingerprint: "b", Time: base.Add(2 * time.Second)},
+		{Fingerprint: "c", Time: base.Add(2 * time.Minute)},
+	} {
+		rp.allow(&r)
+	}
+	if _, ok := rp.lastSent["b"]; ok {
+		t.Error("expired fingerprint b was not pruned")
+	}
+	if _, ok := rp.lastSent["a"]; !ok {
+		t.Error("fingerprint a with suppressed reports was pruned")
+	}
+	if len(rp.lastSent) != 2 {
+		t.Errorf("lastSent = %v, want a and c", rp.lastSent)
+	}
+}
+
+type recordingSink struct {
+	mu      sync.Mutex
+	reports []Report
+}
+
+func (s *recordingSink) Send(ctx context.Context, report Report) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reports = append(s.reports, report)
+	return nil
+}
+
+func TestReporterCapture(t *testing.T) {
+	sink := &recordingSink{}
+	rp := NewReporter(sink, Options{DedupWindow: time.Minute, QueueSize: 2})
+	now := time.Now()
+
+	if !rp.Capture(Report{Fingerprint: "a", Time: now}) {
+		t.Fatal("first report was not queued")
+	}
+	if rp.Capture(Report{Fingerprint: "a", Time: now}) {
+		t.Error("duplicate report was queued")
+	}
+	if !rp.Capture(Report{Fingerprint: "b", Time: now}) {
+		t.Fatal("second fingerprint was not queued")
+	}
+	// 워커 시작 전이라 대기열(2)이 가득 참
+	if rp.Capture(Report{Fingerprint: "c", Time: now}) {
+		t.Error("report was queued beyond QueueSize")
+	}
+
+	rp.Start()
+	rp.Stop()
+
+	if len(sink.reports) != 2 || sink.reports[0].Fingerprint != "a" || sink.reports[1].Fingerprint != "b" {
+		t.Errorf("sent = %+v, want a and b", sink.reports)
+	}
+}
@@ -0,0 +1,187 @@
+package crash
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	sentryClient  = "step-journey/1.0"
+	sentryVersion = 7
+)
+
+// SentrySink Sentry 호환 store API 로 이벤트 전송 (Sentry, GlitchTip, crash-sink 명령 등)
+// DSN: {scheme}://{public_key}@{host}[/{path}]/{project_id}
+type SentrySink struct {
+	storeURL    string
+	authHeader  string
+	environment string
+	serverName  string
+	client      *http.Client
+}
+
+func NewSentrySink(dsn, environment string) (*SentrySink, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return nil, errors.Wrap(err, "[NewSentrySink] parse dsn failed")
+	}
+	if u.User == nil || u.User.Username() == "" {
+		return nil, errors.New("[NewSentrySink] dsn has no public key")
+	}
+	prefix, projectID := path.Split(strings.TrimSuffix(u.Path, "/"))
+	if projectID == "" {
+		return nil, errors.New("[NewSentrySink] dsn has no project id")
+	}
+
+	storeURL := url.URL{
+		Scheme: u.Scheme,
+		Host:   u.Host,
+		Path:   path.Join(prefix, "api", projectID, "store") + "/",
+	}
+	serverName, _ := os.Hostname()
+	return &SentrySink{
+		storeURL: storeURL.String(),
+		authHeader: fmt.Sprintf("Sentry sentry_version=%d, sentry_client=%s, sentry_key=%s",
+			sentryVersion, sentryClient, u.User.Username()),
+		environment: environment,
+		serverName:  serverName,
+		client:      &http.Client{Timeout: sendTimeout},
+	}, nil
+}
+
+func (s *SentrySink) Send(ctx context.Context, report Report) error {
+	body, err := json.Marshal(s.event(report))
+	if err != nil {
+		return errors.Wrap(err, "[SentrySink.Send] marshal event failed")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.storeURL, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "[SentrySink.Send] create request failed")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Sentry-Auth", s.authHeader)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "[SentrySink.Send] request failed")
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}()
+
+	if resp.StatusCode/100 != 2 {
+		return errors.Errorf("[SentrySink.Send] unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// sentryEvent store API 이벤트 본문 (필요한 필드만)
+type sentryEvent struct {
+	EventID     string            `json:"event_id"`
+	Timestamp   string            `json:"timestamp"`
+	Level       string            `json:"level"`
+	Platform    string            `json:"platform"`
+	Logger      string            `json:"logger"`
+	ServerName  string            `json:"server_name,omitempty"`
+	Environment string            `json:"environment,omitempty"`
+	Transaction string            `json:"transaction,omitempty"`
+	Fingerprint []string          `json:"fingerprint"`
+	Tags        map[string]string `json:"tags"`
+	Extra       map[string]int    `json:"extra,omitempty"`
+	Request     sentryRequest     `json:"request"`
+	Exception   struct {
+		Values []sentryException `json:"values"`
+	} `json:"exception"`
+}
+
+type sentryRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+}
+
+type sentryException struct {
+	Type       string `json:"type"`
+	Value      string `json:"value"`
+	Stacktrace struct {
+		Frames []sentryFrame `json:"frames"`
+	} `json:"stacktrace"`
+}
+
+type sentryFrame struct {
+	Function string `json:"function"`
+	Module   string `json:"module,omitempty"`
+	AbsPath  string `json:"abs_path"`
+	Filename string `json:"filename"`
+	Lineno   int    `json:"lineno"`
+	InApp    bool   `json:"in_app"`
+}
+
+func (s *SentrySink) event(report Report) sentryEvent {
+	ev := sentryEvent{
+		EventID:     newEventID(),
+		Timestamp:   report.Time.UTC().Format(time.RFC3339),
+		Level:       "fatal",
+		Platform:    "go",
+		Logger:      "recover",
+		ServerName:  s.serverName,
+		Environment: s.environment,
+		Transaction: report.Route,
+		Fingerprint: []string{report.Fingerprint},
+		Tags: map[string]string{
+			"request_id": report.RequestID,
+			"route":      report.Route,
+		},
+		Request: sentryRequest{Method: report.Method, URL: report.Path},
+	}
+	if report.Suppressed > 0 {
+		ev.Extra = map[string]int{"suppressed_duplicates": report.Suppressed}
+	}
+
+	exc := sentryException{Type: report.PanicType, Value: report.Panic}
+	// Sentry 는 바깥 호출이 먼저 오는 순서
+	for i := len(report.Frames) - 1; i >= 0; i-- {
+		f := report.Frames[i]
+		module, function := splitFunction(f.Function)
+		exc.Stacktrace.Frames = append(exc.Stacktrace.Frames, sentryFrame{
+			Function: function,
+			Module:   module,
+			AbsPath:  f.File,
+			Filename: path.Base(f.File),
+			Lineno:   f.Line,
+			InApp:    strings.HasPrefix(module, "server/"),
+		})
+	}
+	ev.Exception.Values = []sentryException{exc}
+	return ev
+}
+
+// splitFunction "server/internal/handler.(*UserHandler).HandleMe" → ("server/internal/handler", "(*UserHandler).HandleMe")
+func splitFunction(name string) (string, string) {
+	slash := strings.LastIndex(name, "/")
+	dot := strings.Index(name[slash+1:], ".")
+	if dot < 0 {
+		return "", name
+	}
+	return name[:slash+1+dot], name[slash+1+dot+1:]
+}
+
+func newEventID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
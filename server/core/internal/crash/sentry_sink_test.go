@@ -0,0 +1,144 @@
+package crash
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewSentrySink(t *testing.T) {
+	tests := []struct {
+		name         string
+		dsn          string
+		wantStoreURL string
+		wantErr      bool
+	}{
+		{"project only", "https://abc123@o1.ingest.sentry.io/42", "https://o1.ingest.sentry.io/api/42/store/", false},
+		{"path prefix", "http://key@localhost:9000/sentry/7", "http://localhost:9000/sentry/api/7/store/", false},
+		{"trailing slash", "http://key@localhost:9000/7/", "http://localhost:9000/api/7/store/", false},
+		{"no public key", "https://o1.ingest.sentry.io/42", "", true},
+		{"no project id", "https://abc123@o1.ingest.sentry.io/", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := NewSentrySink(tt.dsn, "test")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && s.storeURL != tt.wantStoreURL {
+				t.Errorf("storeURL = %q, want %q", s.storeURL, tt.wantStoreURL)
+			}
+		})
+	}
+}
+
+func TestSentrySinkSend(t *testing.T) {
+	var (
+		gotPath string
+		gotAuth string
+		gotType string
+		gotBody []byte
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotAuth = r.Header.Get("X-Sentry-Auth")
+		gotType = r.Header.Get("Content-Type")
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	dsn := strings.Replace(srv.URL, "://", "://pubkey@", 1) + "/sentry/42"
+	sink, err := NewSentrySink(dsn, "staging")
+	if err != nil {
+		t.Fatalf("NewSentrySink: %v", err)
+	}
+
+	report := Report{
+		Time:      time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC),
+		RequestID: "req-1",
+		Method:    http.MethodGet,
+		Path:      "/api/v1/users/me",
+		Route:     "GET /api/v1/users/me",
+		PanicType: "runtime.boundsError",
+		Panic:     "index out of range [3] with length 1",
+		Frames: []Frame{
+			{Function: "server/internal/handler.(*UserHandler).HandleMe", File: "/app/internal/handler/user_handler.go", Line: 42},
+			{Function: "net/http.HandlerFunc.ServeHTTP", File: "/usr/local/go/src/net/http/server.go", Line: 2220},
+		},
+		Fingerprint: "0123456789abcdef",
+		Suppressed:  3,
+	}
+	if err := sink.Send(context.Background(), report); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	if gotPath != "/sentry/api/42/store/" {
+		t.Errorf("path = %q", gotPath)
+	}
+	if want := "Sentry sentry_version=7, sentry_client=step-journey/1.0, sentry_key=pubkey"; gotAuth != want {
+		t.Errorf("X-Sentry-Auth = %q, want %q", gotAuth, want)
+	}
+	if gotType != "application/json" {
+		t.Errorf("Content-Type = %q", gotType)
+	}
+
+	var ev sentryEvent
+	if err := json.Unmarshal(gotBody, &ev); err != nil {
+		t.Fatalf("decode event: %v (%s)", err, gotBody)
+	}
+	if len(ev.EventID) != 32 || ev.Timestamp != "2026-03-01T12:30:00Z" || ev.Level != "fatal" ||
+		ev.Environment != "staging" || ev.Transaction != report.Route {
+		t.Errorf("event header = %+v", ev)
+	}
+	if len(ev.Fingerprint) != 1 || ev.Fingerprint[0] != report.Fingerprint {
+		t.Errorf("fingerprint = %v", ev.Fingerprint)
+	}
+	if ev.Tags["request_id"] != "req-1" || ev.Request.Method != http.MethodGet || ev.Request.URL != report.Path {
+		t.Errorf("tags = %v, request = %+v", ev.Tags, ev.Request)
+	}
+	if ev.Extra["suppressed_duplicates"] != 3 {
+		t.Errorf("extra = %v, want suppressed_duplicates=3", ev.Extra)
+	}
+
+	if len(ev.Exception.Values) != 1 {
+		t.Fatalf("exception values = %d, want 1", len(ev.Exception.Values))
+	}
+	exc := ev.Exception.Values[0]
+	if exc.Type != report.PanicType || exc.Value != report.Panic {
+		t.Errorf("exception = %s: %s", exc.Type, exc.Value)
+	}
+	// Sentry 는 바깥 호출이 먼저
+	want := []sentryFrame{
+		{Function: "HandlerFunc.ServeHTTP", Module: "net/http", AbsPath: "/usr/local/go/src/net/http/server.go", Filename: "server.go", Lineno: 2220},
+		{Function: "(*UserHandler).HandleMe", Module: "server/internal/handler", AbsPath: "/app/internal/handler/user_handler.go", Filename: "user_handler.go", Lineno: 42, InApp: true},
+	}
+	if len(exc.Stacktrace.Frames) != len(want) {
+		t.Fatalf("frames = %+v", exc.Stacktrace.Frames)
+	}
+	for i := range want {
+		if exc.Stacktrace.Frames[i] != want[i] {
+			t.Errorf("frame %d = %+v, want %+v", i, exc.Stacktrace.Frames[i], want[i])
+		}
+	}
+}
+
+func TestSentrySinkSendErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	sink, err := NewSentrySink(strings.Replace(srv.URL, "://", "://pubkey@", 1)+"/1", "test")
+	if err != nil {
+		t.Fatalf("NewSentrySink: %v", err)
+	}
+	if err := sink.Send(context.Background(), Report{Time: time.Now()}); err == nil {
+		t.Error("Send succeeded on 429, want error")
+	}
+}
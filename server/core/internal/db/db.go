@@ -36,6 +36,7 @@ func NewDB(host string, port int, user, pass, dbName, sslMode string) (*DB, erro
 	}
 
 	cfg.MaxConns = 10
+	cfg.ConnConfig.Tracer = newQueryTracer()
 
 	pool, err := pgxpool.NewWithConfig(context.Background(), cfg)
 	if err != nil {
@@ -0,0 +1,51 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/tracelog"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// newQueryTracer 리포지토리 쿼리를 요청 로거(log.Ctx)로 기록 → request_id, user_id 로 요청과 연결
+// 쿼리는 debug 레벨 (prod 에서는 남지 않음), 쿼리 에러는 호출한 쪽에서 처리하므로 warn
+// 바인딩 인자에는 토큰/개인정보가 들어갈 수 있어 남기지 않음
+func newQueryTracer() *tracelog.TraceLog {
+	return &tracelog.TraceLog{
+		Logger:   tracelog.LoggerFunc(logQuery),
+		LogLevel: tracelog.LogLevelInfo,
+	}
+}
+
+func logQuery(ctx context.Context, level tracelog.LogLevel, msg string, data map[string]interface{}) {
+	logger := log.Ctx(ctx)
+	var event *zerolog.Event
+	switch level {
+	case tracelog.LogLevelError, tracelog.LogLevelWarn:
+		event = logger.Warn()
+	default:
+		event = logger.Debug()
+	}
+	if !event.Enabled() {
+		return
+	}
+
+	for key, value := range data {
+		switch key {
+		case "args":
+		case "err":
+			if err, ok := value.(error); ok {
+				event.Err(err)
+			}
+		case "time":
+			if d, ok := value.(time.Duration); ok {
+				event.Dur("latency", d)
+			}
+		default:
+			event.Interface(key, value)
+		}
+	}
+	event.Msg("[db] " + msg)
+}
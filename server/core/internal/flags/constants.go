@@ -25,4 +25,12 @@ const (
 	EnvKeyOauthNaverClientSecret  = "OAUTH_NAVER_CLIENT_SECRET"
 	EnvKeyOauthKakaoRestApiKey    = "OAUTH_KAKAO_REST_API_KEY"
 	EnvKeyOauthKakaoClientSecret  = "OAUTH_KAKAO_CLIENT_SECRET"
+	EnvKeyOauthKakaoAdminKey      = "OAUTH_KAKAO_ADMIN_KEY"
+
+	// S3 호환 저장소 인증 정보 (storage.driver=s3)
+	EnvKeyStorageS3AccessKey = "STORAGE_S3_ACCESS_KEY"
+	EnvKeyStorageS3SecretKey = "STORAGE_S3_SECRET_KEY"
+
+	// 크래시 리포트 DSN (crash_report.dsn 보다 우선)
+	EnvKeyCrashReportDSN = "CRASH_REPORT_DSN"
 )
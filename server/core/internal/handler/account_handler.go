@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"net/http"
+	"server/internal/apperror"
+	"server/internal/config"
+	"server/internal/cookie"
+	"server/internal/principal"
+	"server/internal/service"
+
+	"github.com/pkg/errors"
+)
+
+type AccountHandler struct {
+	cfg        *config.AppConfig
+	cookies    *cookie.Policy
+	accountSvc *service.AccountService
+}
+
+func NewAccountHandler(cfg *config.AppConfig, cookies *cookie.Policy, accountSvc *service.AccountService) *AccountHandler {
+	return &AccountHandler{
+		cfg:        cfg,
+		cookies:    cookies,
+		accountSvc: accountSvc,
+	}
+}
+
+// DeleteMe 회원 탈퇴: soft delete 후 세션/쿠키 제거, 유예 기간 이후 익명화
+func (h *AccountHandler) DeleteMe(w http.ResponseWriter, r *http.Request) {
+	userID, ok := principal.UserID(r.Context())
+	if !ok {
+		apperror.Write(w, r, errNoPrincipal)
+		return
+	}
+
+	user, err := h.accountSvc.DeleteAccount(r.Context(), userID)
+	if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[DeleteMe] delete account failed, userID=%d", userID))
+		return
+	}
+
+	clearAuthCookies(w, h.cookies)
+
+	resp := map[string]interface{}{
+		"id":          user.ID,
+		"deleted_at":  user.DeletedAt,
+		"purge_after": user.PurgeAfter,
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"net/http"
+	"server/internal/apperror"
+	"server/internal/model"
+	"server/internal/pathparam"
+	"server/internal/principal"
+	"server/internal/service"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// AdminHandler 관리자 유저 관리 API (/api/v1/admin/users/{id}/...), RequireRole(ADMIN) 뒤에서만 사용
+// SuspendRequest POST /api/v1/admin/users/{id}/suspend
+type SuspendRequest struct {
+	Until  time.Time `json:"until" validate:"required"`
+	Reason string    `json:"reason" validate:"required,max=1000"`
+}
+
+// ChangeRoleRequest PATCH /api/v1/admin/users/{id}/role
+type ChangeRoleRequest struct {
+	Role   string `json:"role" validate:"required,enum=USER|ADMIN"`
+	Reason string `json:"reason" validate:"required,max=1000"`
+}
+
+// AdminReasonRequest 사유만 받는 조치 (ban, reinstate)
+type AdminReasonRequest struct {
+	Reason string `json:"reason" validate:"required,max=1000"`
+}
+
+// AdminNoteRequest POST /api/v1/admin/users/{id}/notes
+type AdminNoteRequest struct {
+	Note string `json:"note" validate:"required,max=1000"`
+}
+
+type AdminHandler struct {
+	adminSvc    *service.AdminService
+	activitySvc *service.ActivityService
+}
+
+func NewAdminHandler(adminSvc *service.AdminService, activitySvc *service.ActivityService) *AdminHandler {
+	return &AdminHandler{adminSvc: adminSvc, activitySvc: activitySvc}
+}
+
+// GetUser 유저 상세 (상태, 정지 사유 포함)
+func (h *AdminHandler) GetUser(w http.ResponseWriter, r *http.Request) {
+	_, userID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+	user, err := h.adminSvc.GetUser(r.Context(), userID)
+	h.writeResult(w, r, "[AdminHandler.GetUser]", user, err)
+}
+
+// Suspend {"until": "RFC3339", "reason": "..."}
+func (h *AdminHandler) Suspend(w http.ResponseWriter, r *http.Request) {
+	adminID, userID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+	req, err := Bind[SuspendRequest](r)
+	if err != nil {
+		apperror.Write(w, r, err)
+		return
+	}
+	user, err := h.adminSvc.Suspend(r.Context(), adminID, userID, req.Until, req.Reason)
+	h.writeResult(w, r, "[AdminHandler.Suspend]", user, err)
+}
+
+// Ban {"reason": "..."}
+func (h *AdminHandler) Ban(w http.ResponseWriter, r *http.Request) {
+	adminID, userID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+	reason, ok := decodeReason(w, r)
+	if !ok {
+		return
+	}
+	user, err := h.adminSvc.Ban(r.Context(), adminID, userID, reason)
+	h.writeResult(w, r, "[AdminHandler.Ban]", user, err)
+}
+
+// Reinstate {"reason": "..."} 정지/차단 해제
+func (h *AdminHandler) Reinstate(w http.ResponseWriter, r *http.Request) {
+	adminID, userID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+	reason, ok := decodeReason(w, r)
+	if !ok {
+		return
+	}
+	user, err := h.adminSvc.Reinstate(r.Context(), adminID, userID, reason)
+	h.writeResult(w, r, "[AdminHandler.Reinstate]", user, err)
+}
+
+// ChangeRole {"role": "USER|ADMIN", "reason": "..."}
+func (h *AdminHandler) ChangeRole(w http.ResponseWriter, r *http.Request) {
+	adminID, userID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+	req, err := Bind[ChangeRoleRequest](r)
+	if err != nil {
+		apperror.Write(w, r, err)
+		return
+	}
+	user, err := h.adminSvc.ChangeRole(r.Context(), adminID, userID, req.Role, req.Reason)
+	h.writeResult(w, r, "[AdminHandler.ChangeRole]", user, err)
+}
+
+func (h *AdminHandler) ListNotes(w http.ResponseWriter, r *http.Request) {
+	_, userID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+	notes, err := h.adminSvc.ListNotes(r.Context(), userID)
+	if notes == nil {
+		notes = []model.AdminNote{}
+	}
+	h.writeResult(w, r, "[AdminHandler.ListNotes]", map[string]interface{}{"items": notes}, err)
+}
+
+// AddNote {"note": "..."}
+func (h *AdminHandler) AddNote(w http.ResponseWriter, r *http.Request) {
+	adminID, userID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+	req, err := Bind[AdminNoteRequest](r)
+	if err != nil {
+		apperror.Write(w, r, err)
+		return
+	}
+	note, err := h.adminSvc.AddNote(r.Context(), adminID, userID, req.Note)
+	h.writeResult(w, r, "[AdminHandler.AddNote]", note, err)
+}
+
+// ListActions 조치 이력 (최신순)
+func (h *AdminHandler) ListActions(w http.ResponseWriter, r *http.Request) {
+	_, userID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+	actions, err := h.adminSvc.ListActions(r.Context(), userID)
+	if actions == nil {
+		actions = []model.AdminAction{}
+	}
+	h.writeResult(w, r, "[AdminHandler.ListActions]", map[string]interface{}{"items": actions}, err)
+}
+
+// ActivityMetrics GET /api/v1/admin/metrics/activity?date=YYYY-MM-DD&days=14
+// date 를 생략하면 오늘 (Asia/Seoul 기준), days 는 일별 DAU 추이 길이 (최대 90)
+func (h *AdminHandler) ActivityMetrics(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	date := time.Now()
+	if v := q.Get("date"); v != "" {
+		d, err := time.Parse(time.DateOnly, v)
+		if err != nil {
+			apperror.Write(w, r, errInvalidQuery.WithDetail("param", "date").Wrap(err))
+			return
+		}
+		date = d
+	}
+	days := 0
+	if v := q.Get("days"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			apperror.Write(w, r, errInvalidQuery.WithDetail("param", "days"))
+			return
+		}
+		days = n
+	}
+
+	metrics, err := h.activitySvc.Metrics(r.Context(), date, days)
+	h.writeResult(w, r, "[AdminHandler.ActivityMetrics]", metrics, err)
+}
+
+// parseRequest 관리자 ID(Principal)와 경로의 대상 유저 ID
+func (h *AdminHandler) parseRequest(w http.ResponseWriter, r *http.Request) (int, int, bool) {
+	adminID, ok := principal.UserID(r.Context())
+	if !ok {
+		apperror.Write(w, r, errNoPrincipal)
+		return 0, 0, false
+	}
+	userID, err := pathparam.ID(r, "id")
+	if err != nil {
+		apperror.Write(w, r, err)
+		return 0, 0, false
+	}
+	return adminID, userID, true
+}
+
+func decodeReason(w http.ResponseWriter, r *http.Request) (string, bool) {
+	req, err := Bind[AdminReasonRequest](r)
+	if err != nil {
+		apperror.Write(w, r, err)
+		return "", false
+	}
+	return req.Reason, true
+}
+
+// writeResult 서비스 결과를 JSON 응답 (에러는 apperror 공통 응답)
+func (h *AdminHandler) writeResult(w http.ResponseWriter, r *http.Request, logTag string, result interface{}, err error) {
+	if err != nil {
+		apperror.Write(w, r, errors.Wrap(err, logTag+" failed"))
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
@@ -2,21 +2,35 @@ package handler
 
 import (
 	"net/http"
+	"net/url"
+	"server/internal/apperror"
 	"server/internal/config"
+	"server/internal/cookie"
+	"server/internal/model"
 	"server/internal/service"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
 )
 
+var (
+	errMissingOAuthCode = apperror.BadRequest("MISSING_OAUTH_CODE", "code 파라미터가 없습니다.")
+	errOAuthFailed      = apperror.External("OAUTH_FAILED", "소셜 로그인에 실패했습니다.")
+	errNoRestoreToken   = apperror.Unauthorized("RESTORE_TOKEN_REQUIRED", "계정 복구 토큰이 없습니다.")
+	errInvalidRestore   = apperror.Unauthorized("RESTORE_TOKEN_INVALID", "계정 복구 토큰이 유효하지 않습니다.")
+)
+
 type AuthHandler struct {
 	cfg         *config.AppConfig
+	cookies     *cookie.Policy
 	authService *service.AuthService
 }
 
-func NewAuthHandler(cfg *config.AppConfig, authSvc *service.AuthService) *AuthHandler {
+func NewAuthHandler(cfg *config.AppConfig, cookies *cookie.Policy, authSvc *service.AuthService) *AuthHandler {
 	return &AuthHandler{
 		cfg:         cfg,
+		cookies:     cookies,
 		authService: authSvc,
 	}
 }
@@ -24,8 +38,7 @@ func NewAuthHandler(cfg *config.AppConfig, authSvc *service.AuthService) *AuthHa
 func (h *AuthHandler) HandleGoogleLogin(w http.ResponseWriter, r *http.Request) {
 	loginURL, err := h.authService.GetGoogleLoginURL()
 	if err != nil {
-		log.Error().Err(err).Msg("[HandleGoogleLogin] failed to build google login URL")
-		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		apperror.Write(w, r, errors.Wrap(err, "[HandleGoogleLogin] failed to build google login URL"))
 		return
 	}
 	http.Redirect(w, r, loginURL, http.StatusFound)
@@ -34,32 +47,24 @@ func (h *AuthHandler) HandleGoogleLogin(w http.ResponseWriter, r *http.Request)
 func (h *AuthHandler) HandleGoogleCallback(w http.ResponseWriter, r *http.Request) {
 	code := r.URL.Query().Get("code")
 	if code == "" {
-		http.Error(w, "Missing code param", http.StatusBadRequest)
+		apperror.Write(w, r, errMissingOAuthCode)
 		return
 	}
 	// 1) OAuth 처리
 	user, err := h.authService.ProcessGoogleCallback(r.Context(), code)
 	if err != nil {
-		log.Error().Err(err).Msg("[HandleGoogleCallback] Google OAuth failed")
-		http.Error(w, "Google OAuth failed", http.StatusInternalServerError)
+		apperror.Write(w, r, errOAuthFailed.WithDetail("provider", "google").Wrap(err))
 		return
 	}
 
 	// 2) 로그인 후 쿠키 저장
-	if err := h.authService.LoginUserAndSetCookies(w, user); err != nil {
-		log.Error().Err(err).Msg("[HandleGoogleCallback] LoginUserAndSetCookies failed")
-		http.Error(w, "Login failed", http.StatusInternalServerError)
-		return
-	}
-
-	http.Redirect(w, r, h.cfg.Endpoints.FrontendBaseURL+"?login=success", http.StatusFound)
+	h.completeLogin(w, r, user, "[HandleGoogleCallback]")
 }
 
 func (h *AuthHandler) HandleKakaoLogin(w http.ResponseWriter, r *http.Request) {
 	loginURL, err := h.authService.GetKakaoLoginURL()
 	if err != nil {
-		log.Error().Err(err).Msg("[HandleKakaoLogin] failed to build kakao login URL")
-		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		apperror.Write(w, r, errors.Wrap(err, "[HandleKakaoLogin] failed to build kakao login URL"))
 		return
 	}
 	http.Redirect(w, r, loginURL, http.StatusFound)
@@ -68,30 +73,22 @@ func (h *AuthHandler) HandleKakaoLogin(w http.ResponseWriter, r *http.Request) {
 func (h *AuthHandler) HandleKakaoCallback(w http.ResponseWriter, r *http.Request) {
 	code := r.URL.Query().Get("code")
 	if code == "" {
-		http.Error(w, "Missing code param", http.StatusBadRequest)
+		apperror.Write(w, r, errMissingOAuthCode)
 		return
 	}
 	user, err := h.authService.ProcessKakaoCallback(r.Context(), code)
 	if err != nil {
-		log.Error().Err(err).Msg("[HandleKakaoCallback] Kakao OAuth failed")
-		http.Error(w, "Kakao OAuth failed", http.StatusInternalServerError)
+		apperror.Write(w, r, errOAuthFailed.WithDetail("provider", "kakao").Wrap(err))
 		return
 	}
 
-	if err := h.authService.LoginUserAndSetCookies(w, user); err != nil {
-		log.Error().Err(err).Msg("[HandleKakaoCallback] LoginUserAndSetCookies failed")
-		http.Error(w, "Login failed", http.StatusInternalServerError)
-		return
-	}
-
-	http.Redirect(w, r, h.cfg.Endpoints.FrontendBaseURL+"?login=success", http.StatusFound)
+	h.completeLogin(w, r, user, "[HandleKakaoCallback]")
 }
 
 func (h *AuthHandler) HandleNaverLogin(w http.ResponseWriter, r *http.Request) {
 	loginURL, err := h.authService.GetNaverLoginURL()
 	if err != nil {
-		log.Error().Err(err).Msg("[HandleNaverLogin] failed to build naver login URL")
-		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		apperror.Write(w, r, errors.Wrap(err, "[HandleNaverLogin] failed to build naver login URL"))
 		return
 	}
 	http.Redirect(w, r, loginURL, http.StatusFound)
@@ -101,55 +98,87 @@ func (h *AuthHandler) HandleNaverCallback(w http.ResponseWriter, r *http.Request
 	code := r.URL.Query().Get("code")
 	state := r.URL.Query().Get("state")
 	if code == "" {
-		http.Error(w, "Missing code param", http.StatusBadRequest)
+		apperror.Write(w, r, errMissingOAuthCode)
 		return
 	}
 	user, err := h.authService.ProcessNaverCallback(r.Context(), code, state)
 	if err != nil {
-		log.Error().Err(err).Msg("[HandleNaverCallback] Naver OAuth failed")
-		http.Error(w, "Naver OAuth failed", http.StatusInternalServerError)
+		apperror.Write(w, r, errOAuthFailed.WithDetail("provider", "naver").Wrap(err))
 		return
 	}
 
+	h.completeLogin(w, r, user, "[HandleNaverCallback]")
+}
+
+// completeLogin OAuth 콜백 공통: 로그인 쿠키 설정 후 프론트엔드로 리다이렉트
+// 탈퇴 유예 기간 중인 계정이면 복구 여부를 묻도록 login=restore_required 로 리다이렉트
+// 온보딩을 마치지 않은 유저면 onboarding=required 를 붙여 프론트가 온보딩 화면으로 분기
+func (h *AuthHandler) completeLogin(w http.ResponseWriter, r *http.Request, user *model.User, logTag string) {
 	if err := h.authService.LoginUserAndSetCookies(w, user); err != nil {
-		log.Error().Err(err).Msg("[HandleNaverCallback] LoginUserAndSetCookies failed")
-		http.Error(w, "Login failed", http.StatusInternalServerError)
+		if errors.Is(err, service.ErrAccountPendingDeletion) {
+			log.Ctx(r.Context()).Info().Int("user_id", user.ID).Msg(logTag + " account is pending deletion, asking for restoration")
+			http.Redirect(w, r, h.cfg.Endpoints.FrontendBaseURL+"?login=restore_required", http.StatusFound)
+			return
+		}
+		if errors.Is(err, service.ErrAccountBlocked) {
+			log.Ctx(r.Context()).Info().Int("user_id", user.ID).Msg(logTag + " blocked account tried to log in")
+			http.Redirect(w, r, h.cfg.Endpoints.FrontendBaseURL+"?"+blockedLoginQuery(user), http.StatusFound)
+			return
+		}
+		apperror.Write(w, r, errors.Wrap(err, logTag+" LoginUserAndSetCookies failed"))
 		return
 	}
 
-	http.Redirect(w, r, h.cfg.Endpoints.FrontendBaseURL+"?login=success", http.StatusFound)
+	query := "?login=success"
+	if user.NeedsOnboarding() {
+		query += "&onboarding=required"
+	}
+	http.Redirect(w, r, h.cfg.Endpoints.FrontendBaseURL+query, http.StatusFound)
 }
 
-func (h *AuthHandler) HandleLogout(w http.ResponseWriter, r *http.Request) {
-	log.Info().Msg("[HandleLogout] Processing logout request")
-
-	// AccessToken 쿠키 무효화
-	http.SetCookie(w, &http.Cookie{
-		Name:     "access_token",
-		Value:    "",
-		Path:     "/",
-		Domain:   h.cfg.CookieDomain,
-		Secure:   h.cfg.CookieDomain != "localhost",
-		HttpOnly: true,
-		Expires:  time.Unix(0, 0),
-		MaxAge:   -1,
-		SameSite: http.SameSiteLaxMode,
-	})
+// blockedLoginQuery 프론트 안내 화면용 쿼리 (login=blocked&code=ACCOUNT_SUSPENDED&reason=...&until=...)
+func blockedLoginQuery(user *model.User) string {
+	status := user.AccountStatus()
+	q := url.Values{}
+	q.Set("login", "blocked")
+	q.Set("code", status.BlockCode(time.Now()))
+	q.Set("reason", status.Reason)
+	if status.SuspendedUntil != nil {
+		q.Set("until", status.SuspendedUntil.UTC().Format(time.RFC3339))
+	}
+	return q.Encode()
+}
+
+// HandleRestoreAccount 탈퇴 유예 기간 중 재로그인한 유저가 계정 복구를 확정 (restore_token 쿠키 필요)
+func (h *AuthHandler) HandleRestoreAccount(w http.ResponseWriter, r *http.Request) {
+	restoreToken, ok := h.cookies.Value(r, cookie.RestoreToken)
+	if !ok {
+		apperror.Write(w, r, errNoRestoreToken)
+		return
+	}
+
+	user, err := h.authService.RestoreAccountAndLogin(r.Context(), w, restoreToken)
+	if err != nil {
+		apperror.Write(w, r, errInvalidRestore.Wrap(errors.Wrap(err, "[HandleRestoreAccount] restore account failed")))
+		return
+	}
 
-	// RefreshToken 쿠키 무효화
-	http.SetCookie(w, &http.Cookie{
-		Name:     "refresh_token",
-		Value:    "",
-		Path:     "/",
-		Domain:   h.cfg.CookieDomain,
-		Secure:   h.cfg.CookieDomain != "localhost",
-		HttpOnly: true,
-		Expires:  time.Unix(0, 0),
-		MaxAge:   -1,
-		SameSite: http.SameSiteLaxMode,
+	log.Ctx(r.Context()).Info().Int("user_id", user.ID).Msg("[HandleRestoreAccount] account restored")
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"id":       user.ID,
+		"restored": true,
 	})
+}
+
+func (h *AuthHandler) HandleLogout(w http.ResponseWriter, r *http.Request) {
+	clearAuthCookies(w, h.cookies)
 
 	w.WriteHeader(http.StatusOK)
 	_, _ = w.Write([]byte("Logged out successfully"))
-	log.Info().Msg("[HandleLogout] Logout completed, cookies cleared")
+	log.Ctx(r.Context()).Info().Msg("[HandleLogout] Logout completed, cookies cleared")
+}
+
+// clearAuthCookies access_token / refresh_token 쿠키 무효화 (로그아웃, 회원 탈퇴)
+func clearAuthCookies(w http.ResponseWriter, cookies *cookie.Policy) {
+	cookies.Clear(w, cookie.AccessToken, cookie.RefreshToken)
 }
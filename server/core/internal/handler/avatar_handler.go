@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"server/internal/apperror"
+	"server/internal/config"
+	"server/internal/principal"
+	"server/internal/service"
+
+	"github.com/pkg/errors"
+)
+
+// multipart 경계/헤더 등 파일 외 여유분
+const multipartOverheadBytes = 64 << 10
+
+// avatarFormField multipart 요청에서 이미지 파일 필드 이름
+const avatarFormField = "avatar"
+
+var (
+	errMultipartRequired  = apperror.BadRequest("MULTIPART_REQUIRED", "multipart/form-data 형식으로 요청해주세요.")
+	errMalformedMultipart = apperror.BadRequest("MALFORMED_MULTIPART", "multipart 본문을 해석할 수 없습니다.")
+	errMissingAvatar      = apperror.BadRequest("MISSING_AVATAR_FILE", "avatar 필드에 이미지 파일을 첨부해주세요.")
+)
+
+type AvatarHandler struct {
+	cfg       *config.AppConfig
+	avatarSvc *service.AvatarService
+}
+
+func NewAvatarHandler(cfg *config.AppConfig, avatarSvc *service.AvatarService) *AvatarHandler {
+	return &AvatarHandler{
+		cfg:       cfg,
+		avatarSvc: avatarSvc,
+	}
+}
+
+// Upload multipart/form-data 의 avatar 필드로 프로필 이미지 업로드
+func (h *AvatarHandler) Upload(w http.ResponseWriter, r *http.Request) {
+	userID, ok := principal.UserID(r.Context())
+	if !ok {
+		apperror.Write(w, r, errNoPrincipal)
+		return
+	}
+
+	// 본문 전체를 메모리/임시 파일에 올리지 않도록 스트리밍으로 읽음
+	r.Body = http.MaxBytesReader(w, r.Body, h.cfg.Avatar.MaxUploadBytes+multipartOverheadBytes)
+	mr, err := r.MultipartReader()
+	if err != nil {
+		apperror.Write(w, r, errMultipartRequired.Wrap(err))
+		return
+	}
+
+	var data []byte
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			apperror.Write(w, r, service.ErrAvatarTooLarge)
+			return
+		} else if err != nil {
+			apperror.Write(w, r, errMalformedMultipart.Wrap(err))
+			return
+		}
+		if part.FormName() != avatarFormField {
+			part.Close()
+			continue
+		}
+		data, err = h.avatarSvc.ReadLimited(part)
+		part.Close()
+		if errors.Is(err, service.ErrAvatarTooLarge) || errors.As(err, &maxBytesErr) {
+			apperror.Write(w, r, service.ErrAvatarTooLarge)
+			return
+		} else if err != nil {
+			apperror.Write(w, r, errMalformedMultipart.Wrap(err))
+			return
+		}
+		break
+	}
+	if len(data) == 0 {
+		apperror.Write(w, r, errMissingAvatar)
+		return
+	}
+
+	user, err := h.avatarSvc.Upload(r.Context(), userID, data)
+	if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[AvatarHandler.Upload] upload avatar failed, userID=%d", userID))
+		return
+	}
+
+	resp := map[string]interface{}{
+		"profile_image": user.ProfileImage,
+		"thumbnails":    h.avatarSvc.URLs(user),
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
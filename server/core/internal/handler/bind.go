@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"server/internal/apperror"
+	"server/pkg/validator"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// maxBodyBytes JSON 요청 본문 최대 크기 (아바타 업로드는 multipart 로 별도 제한)
+const maxBodyBytes = 1 << 20
+
+var (
+	// errUnsupportedContentType JSON 이 아닌 본문
+	errUnsupportedContentType = apperror.New(apperror.TypeUnsupportedMedia, "UNSUPPORTED_CONTENT_TYPE", "Content-Type 은 application/json 이어야 합니다.")
+	// errBodyTooLarge maxBodyBytes 초과 (details.limit 에 바이트 수)
+	errBodyTooLarge = apperror.New(apperror.TypeTooLarge, "BODY_TOO_LARGE", "요청 본문이 너무 큽니다.").WithDetail("limit", maxBodyBytes)
+)
+
+// bindValidator 요청 구조체 `validate` 태그 검증용 (커스텀 규칙은 RegisterRule 로 추가)
+var bindValidator = validator.NewValidator()
+
+// Bind JSON 본문을 T 로 해석하고 `validate` 태그 검증까지 수행
+//
+//	req, err := Bind[struct {
+//		Handle string `json:"handle" validate:"required,handle"`
+//	}](r)
+//	if err != nil {
+//		apperror.Write(w, r, err)
+//		return
+//	}
+//
+// Content-Type 이 JSON 이 아니면 415, 본문이 1MB 를 넘으면 413, JSON 문법 오류는 400,
+// 알 수 없는 필드/타입 불일치/태그 검증 실패는 필드별 사유와 함께 422 (validator.Errors)
+func Bind[T any](r *http.Request) (T, error) {
+	var v T
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mediaType != "application/json" {
+		return v, errUnsupportedContentType.WithDetail("content_type", r.Header.Get("Content-Type"))
+	}
+
+	dec := json.NewDecoder(http.MaxBytesReader(nil, r.Body, maxBodyBytes))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&v); err != nil {
+		return v, decodeError(err)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return v, errInvalidBody.WithMessage("JSON 값 뒤에 불필요한 데이터가 있습니다.")
+	}
+
+	if err := bindValidator.Struct(v); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+// decodeError json 디코딩 오류를 공통 에러 응답으로 변환
+func decodeError(err error) error {
+	var maxErr *http.MaxBytesError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &maxErr):
+		return errBodyTooLarge.Wrap(err)
+	case errors.As(err, &typeErr):
+		field := typeErr.Field
+		if field == "" {
+			return errInvalidBody.WithMessage("JSON 객체로 요청해주세요.").Wrap(err)
+		}
+		return validator.Errors{{Field: field, Reason: typeErr.Value + " 형식은 사용할 수 없습니다"}}
+	case strings.HasPrefix(err.Error(), "json: unknown field "):
+		// encoding/json 이 별도 타입을 제공하지 않아 메시지로 구분
+		field := strings.Trim(strings.TrimPrefix(err.Error(), "json: unknown field "), `"`)
+		return validator.Errors{{Field: field, Reason: "알 수 없는 필드입니다"}}
+	case errors.Is(err, io.EOF):
+		return errInvalidBody.WithMessage("요청 본문이 비어 있습니다.")
+	}
+	return errInvalidBody.Wrap(err)
+}
@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"server/internal/apperror"
+	"server/internal/pagination"
+	"server/internal/principal"
+	"server/internal/repository"
+	"server/internal/service"
+
+	"github.com/pkg/errors"
+)
+
+// BlockHandler 차단/뮤트 API (/api/v1/users/{id}/block, /mute, /api/v1/users/me/blocks, /mutes)
+type BlockHandler struct {
+	blockSvc *service.BlockService
+}
+
+func NewBlockHandler(blockSvc *service.BlockService) *BlockHandler {
+	return &BlockHandler{blockSvc: blockSvc}
+}
+
+// Block POST /api/v1/users/{id}/block (서로의 팔로우 관계도 삭제됨)
+func (h *BlockHandler) Block(w http.ResponseWriter, r *http.Request) {
+	h.apply(w, r, "[BlockHandler.Block]", h.blockSvc.Block)
+}
+
+// Unblock DELETE /api/v1/users/{id}/block
+func (h *BlockHandler) Unblock(w http.ResponseWriter, r *http.Request) {
+	h.apply(w, r, "[BlockHandler.Unblock]", h.blockSvc.Unblock)
+}
+
+// Mute POST /api/v1/users/{id}/mute
+func (h *BlockHandler) Mute(w http.ResponseWriter, r *http.Request) {
+	h.apply(w, r, "[BlockHandler.Mute]", h.blockSvc.Mute)
+}
+
+// Unmute DELETE /api/v1/users/{id}/mute
+func (h *BlockHandler) Unmute(w http.ResponseWriter, r *http.Request) {
+	h.apply(w, r, "[BlockHandler.Unmute]", h.blockSvc.Unmute)
+}
+
+// ListBlocked GET /api/v1/users/me/blocks?limit=&cursor=
+func (h *BlockHandler) ListBlocked(w http.ResponseWriter, r *http.Request) {
+	userID, page, ok := parseRestrictionList(w, r)
+	if !ok {
+		return
+	}
+	result, err := h.blockSvc.ListBlocked(r.Context(), userID, page)
+	if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[BlockHandler.ListBlocked] list failed, userID=%d", userID))
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// ListMuted GET /api/v1/users/me/mutes?limit=&cursor=
+func (h *BlockHandler) ListMuted(w http.ResponseWriter, r *http.Request) {
+	userID, page, ok := parseRestrictionList(w, r)
+	if !ok {
+		return
+	}
+	result, err := h.blockSvc.ListMuted(r.Context(), userID, page)
+	if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[BlockHandler.ListMuted] list failed, userID=%d", userID))
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// apply 로그인 유저 → 경로의 유저에 대한 차단/뮤트 등록·해제 공통 처리 (성공 시 204)
+func (h *BlockHandler) apply(w http.ResponseWriter, r *http.Request, logTag string, action func(ctx context.Context, userID, targetID int) error) {
+	userID, targetID, ok := parseFollowTarget(w, r)
+	if !ok {
+		return
+	}
+
+	if err := action(r.Context(), userID, targetID); err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "%s failed, userID=%d, targetID=%d", logTag, userID, targetID))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func parseRestrictionList(w http.ResponseWriter, r *http.Request) (int, pagination.Request, bool) {
+	userID, ok := principal.UserID(r.Context())
+	if !ok {
+		apperror.Write(w, r, errNoPrincipal)
+		return 0, pagination.Request{}, false
+	}
+	page, err := pagination.ParseRequest(r.URL.Query(), repository.RestrictedUserSorts, "-created_at")
+	if err != nil {
+		apperror.Write(w, r, err)
+		return 0, pagination.Request{}, false
+	}
+	return userID, page, true
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"net/http"
+	"server/internal/apperror"
+	"server/internal/clientip"
+	"server/internal/config"
+	"server/internal/principal"
+	"server/internal/service"
+
+	"github.com/pkg/errors"
+)
+
+// AcceptConsentsRequest POST /api/v1/users/me/consents
+type AcceptConsentsRequest struct {
+	DocumentIDs []int `json:"document_ids" validate:"required"`
+}
+
+// MarketingConsentRequest POST /api/v1/users/me/consents/marketing
+type MarketingConsentRequest struct {
+	Agreed *bool `json:"agreed" validate:"required"`
+}
+
+type ConsentHandler struct {
+	consentSvc *service.ConsentService
+	// 동의 이력에 남길 IP 계산용 (clientip.Behind)
+	trustedProxies int
+}
+
+func NewConsentHandler(cfg *config.AppConfig, consentSvc *service.ConsentService) *ConsentHandler {
+	return &ConsentHandler{consentSvc: consentSvc, trustedProxies: cfg.RateLimit.TrustedProxies}
+}
+
+// ListDocuments 현재 시행 중인 약관/방침 문서 (공개, 로그인 불필요)
+func (h *ConsentHandler) ListDocuments(w http.ResponseWriter, r *http.Request) {
+	docs, err := h.consentSvc.ListCurrentDocuments(r.Context())
+	if err != nil {
+		apperror.Write(w, r, errors.Wrap(err, "[ListDocuments] list current documents failed"))
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"documents": docs})
+}
+
+// ListMyConsents 내 동의 이력, 아직 동의하지 않은 필수 문서, 마케팅 수신 동의 상태
+func (h *ConsentHandler) ListMyConsents(w http.ResponseWriter, r *http.Request) {
+	userID, ok := principal.UserID(r.Context())
+	if !ok {
+		apperror.Write(w, r, errNoPrincipal)
+		return
+	}
+
+	consents, err := h.consentSvc.ListUserConsents(r.Context(), userID)
+	if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[ListMyConsents] list consents failed, userID=%d", userID))
+		return
+	}
+	pending, err := h.consentSvc.PendingMandatoryDocuments(r.Context(), userID)
+	if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[ListMyConsents] list pending documents failed, userID=%d", userID))
+		return
+	}
+	marketing, err := h.consentSvc.GetMarketingConsent(r.Context(), userID)
+	if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[ListMyConsents] get marketing consent failed, userID=%d", userID))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"consents":          consents,
+		"pending_documents": pending,
+		"marketing_agreed":  marketing != nil && marketing.Agreed,
+	})
+}
+
+// AcceptConsents 필수/선택 문서 동의 (document_ids 는 현재 시행 중인 버전이어야 함)
+func (h *ConsentHandler) AcceptConsents(w http.ResponseWriter, r *http.Request) {
+	userID, ok := principal.UserID(r.Context())
+	if !ok {
+		apperror.Write(w, r, errNoPrincipal)
+		return
+	}
+
+	req, err := Bind[AcceptConsentsRequest](r)
+	if err != nil {
+		apperror.Write(w, r, err)
+		return
+	}
+
+	err = h.consentSvc.Accept(r.Context(), userID, req.DocumentIDs, clientip.Behind(r, h.trustedProxies), r.UserAgent())
+	if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[AcceptConsents] accept failed, userID=%d", userID))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// UpdateMarketingConsent 마케팅 수신 동의/철회
+func (h *ConsentHandler) UpdateMarketingConsent(w http.ResponseWriter, r *http.Request) {
+	userID, ok := principal.UserID(r.Context())
+	if !ok {
+		apperror.Write(w, r, errNoPrincipal)
+		return
+	}
+
+	req, err := Bind[MarketingConsentRequest](r)
+	if err != nil {
+		apperror.Write(w, r, err)
+		return
+	}
+
+	consent, err := h.consentSvc.SetMarketingConsent(r.Context(), userID, *req.Agreed, clientip.Behind(r, h.trustedProxies), r.UserAgent())
+	if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[UpdateMarketingConsent] set marketing consent failed, userID=%d", userID))
+		return
+	}
+	writeJSON(w, http.StatusOK, consent)
+}
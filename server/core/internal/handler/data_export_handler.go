@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"server/internal/apperror"
+	"server/internal/config"
+	"server/internal/model"
+	"server/internal/pathparam"
+	"server/internal/principal"
+	"server/internal/service"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+type DataExportHandler struct {
+	cfg       *config.AppConfig
+	exportSvc *service.DataExportService
+}
+
+func NewDataExportHandler(cfg *config.AppConfig, exportSvc *service.DataExportService) *DataExportHandler {
+	return &DataExportHandler{
+		cfg:       cfg,
+		exportSvc: exportSvc,
+	}
+}
+
+// RequestExport 개인정보 내보내기 요청 → 202 Accepted (생성은 백그라운드에서 진행)
+func (h *DataExportHandler) RequestExport(w http.ResponseWriter, r *http.Request) {
+	userID, ok := principal.UserID(r.Context())
+	if !ok {
+		apperror.Write(w, r, errNoPrincipal)
+		return
+	}
+
+	export, err := h.exportSvc.RequestExport(r.Context(), userID)
+	if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[RequestExport] request export failed, userID=%d", userID))
+		return
+	}
+
+	w.Header().Set("Location", h.exportURL(export.ID))
+	writeJSON(w, http.StatusAccepted, h.toResponse(export))
+}
+
+// GetExport 요청 상태 조회 (READY 면 download_url 포함)
+func (h *DataExportHandler) GetExport(w http.ResponseWriter, r *http.Request) {
+	export, ok := h.findExport(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, h.toResponse(export))
+}
+
+// Download 기한 내의 READY 아카이브를 저장소에서 읽어 그대로 전송
+func (h *DataExportHandler) Download(w http.ResponseWriter, r *http.Request) {
+	userID, ok := principal.UserID(r.Context())
+	if !ok {
+		apperror.Write(w, r, errNoPrincipal)
+		return
+	}
+	exportID, err := pathparam.ID(r, "id")
+	if err != nil {
+		apperror.Write(w, r, err)
+		return
+	}
+
+	export, body, info, err := h.exportSvc.OpenArchive(r.Context(), userID, exportID)
+	if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[Download] open archive failed, userID=%d, exportID=%d", userID, exportID))
+		return
+	}
+	defer body.Close()
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition",
+		fmt.Sprintf(`attachment; filename="step-journey-export-%d.zip"`, export.ID))
+	if info.Size > 0 {
+		w.Header().Set("Content-Length", strconv.FormatInt(info.Size, 10))
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	if _, err := io.Copy(w, body); err != nil {
+		log.Ctx(r.Context()).Warn().Err(err).Int("export_id", export.ID).Msg("[Download] copy archive failed")
+	}
+}
+
+func (h *DataExportHandler) findExport(w http.ResponseWriter, r *http.Request) (*model.DataExport, bool) {
+	userID, ok := principal.UserID(r.Context())
+	if !ok {
+		apperror.Write(w, r, errNoPrincipal)
+		return nil, false
+	}
+	exportID, err := pathparam.ID(r, "id")
+	if err != nil {
+		apperror.Write(w, r, err)
+		return nil, false
+	}
+
+	export, err := h.exportSvc.GetExport(r.Context(), userID, exportID)
+	if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[findExport] get export failed, userID=%d, exportID=%d", userID, exportID))
+		return nil, false
+	}
+	return export, true
+}
+
+func (h *DataExportHandler) exportURL(exportID int) string {
+	return fmt.Sprintf("%s/api/v1/users/me/exports/%d", h.cfg.Endpoints.BackendBaseURL, exportID)
+}
+
+func (h *DataExportHandler) toResponse(export *model.DataExport) map[string]interface{} {
+	resp := map[string]interface{}{
+		"id":         export.ID,
+		"status":     export.Status,
+		"created_at": export.CreatedAt,
+	}
+	if export.CompletedAt != nil {
+		resp["completed_at"] = export.CompletedAt
+	}
+	if export.IsDownloadable(time.Now()) {
+		resp["download_url"] = h.exportURL(export.ID) + "/download"
+		resp["expires_at"] = export.ExpiresAt
+		resp["file_size"] = export.FileSize
+	}
+	return resp
+}
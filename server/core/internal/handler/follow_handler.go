@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"net/http"
+	"server/internal/apperror"
+	"server/internal/pagination"
+	"server/internal/pathparam"
+	"server/internal/principal"
+	"server/internal/repository"
+	"server/internal/service"
+
+	"github.com/pkg/errors"
+)
+
+// FollowHandler 팔로우 API (/api/v1/users/{id}/follow, /followers, /following, /api/v1/users/me/follow-requests)
+type FollowHandler struct {
+	followSvc *service.FollowService
+}
+
+func NewFollowHandler(followSvc *service.FollowService) *FollowHandler {
+	return &FollowHandler{followSvc: followSvc}
+}
+
+// Follow POST /api/v1/users/{id}/follow → 생성된(또는 기존) 관계, 승인제 유저면 status=PENDING
+func (h *FollowHandler) Follow(w http.ResponseWriter, r *http.Request) {
+	userID, targetID, ok := parseFollowTarget(w, r)
+	if !ok {
+		return
+	}
+	follow, err := h.followSvc.Follow(r.Context(), userID, targetID)
+	h.writeResult(w, r, "[FollowHandler.Follow]", follow, err)
+}
+
+// Unfollow DELETE /api/v1/users/{id}/follow 팔로우 또는 승인 대기 중인 요청 취소
+func (h *FollowHandler) Unfollow(w http.ResponseWriter, r *http.Request) {
+	userID, targetID, ok := parseFollowTarget(w, r)
+	if !ok {
+		return
+	}
+	if err := h.followSvc.Unfollow(r.Context(), userID, targetID); err != nil {
+		h.writeResult(w, r, "[FollowHandler.Unfollow]", nil, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListFollowers GET /api/v1/users/{id}/followers?limit=&cursor=
+func (h *FollowHandler) ListFollowers(w http.ResponseWriter, r *http.Request) {
+	userID, targetID, ok := parseFollowTarget(w, r)
+	if !ok {
+		return
+	}
+	page, ok := parseFollowPage(w, r)
+	if !ok {
+		return
+	}
+	result, err := h.followSvc.ListFollowers(r.Context(), targetID, userID, page)
+	h.writeResult(w, r, "[FollowHandler.ListFollowers]", result, err)
+}
+
+// ListFollowing GET /api/v1/users/{id}/following?limit=&cursor=
+func (h *FollowHandler) ListFollowing(w http.ResponseWriter, r *http.Request) {
+	userID, targetID, ok := parseFollowTarget(w, r)
+	if !ok {
+		return
+	}
+	page, ok := parseFollowPage(w, r)
+	if !ok {
+		return
+	}
+	result, err := h.followSvc.ListFollowing(r.Context(), targetID, userID, page)
+	h.writeResult(w, r, "[FollowHandler.ListFollowing]", result, err)
+}
+
+// ListRequests GET /api/v1/users/me/follow-requests 받은 팔로우 요청 (승인 대기)
+func (h *FollowHandler) ListRequests(w http.ResponseWriter, r *http.Request) {
+	userID, ok := principal.UserID(r.Context())
+	if !ok {
+		apperror.Write(w, r, errNoPrincipal)
+		return
+	}
+	page, ok := parseFollowPage(w, r)
+	if !ok {
+		return
+	}
+	result, err := h.followSvc.ListRequests(r.Context(), userID, page)
+	h.writeResult(w, r, "[FollowHandler.ListRequests]", result, err)
+}
+
+// AcceptRequest POST /api/v1/users/me/follow-requests/{id}/accept ({id}: 요청한 유저 ID)
+func (h *FollowHandler) AcceptRequest(w http.ResponseWriter, r *http.Request) {
+	userID, requesterID, ok := parseFollowTarget(w, r)
+	if !ok {
+		return
+	}
+	follow, err := h.followSvc.AcceptRequest(r.Context(), userID, requesterID)
+	h.writeResult(w, r, "[FollowHandler.AcceptRequest]", follow, err)
+}
+
+// RejectRequest DELETE /api/v1/users/me/follow-requests/{id}
+func (h *FollowHandler) RejectRequest(w http.ResponseWriter, r *http.Request) {
+	userID, requesterID, ok := parseFollowTarget(w, r)
+	if !ok {
+		return
+	}
+	if err := h.followSvc.RejectRequest(r.Context(), userID, requesterID); err != nil {
+		h.writeResult(w, r, "[FollowHandler.RejectRequest]", nil, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseFollowTarget 로그인 유저 ID(Principal)와 경로의 상대 유저 ID
+func parseFollowTarget(w http.ResponseWriter, r *http.Request) (int, int, bool) {
+	userID, ok := principal.UserID(r.Context())
+	if !ok {
+		apperror.Write(w, r, errNoPrincipal)
+		return 0, 0, false
+	}
+	targetID, err := pathparam.ID(r, "id")
+	if err != nil {
+		apperror.Write(w, r, err)
+		return 0, 0, false
+	}
+	return userID, targetID, true
+}
+
+func parseFollowPage(w http.ResponseWriter, r *http.Request) (pagination.Request, bool) {
+	page, err := pagination.ParseRequest(r.URL.Query(), repository.FollowSorts, "-created_at")
+	if err != nil {
+		apperror.Write(w, r, err)
+		return pagination.Request{}, false
+	}
+	return page, true
+}
+
+// writeResult 서비스 결과를 JSON 응답 (에러는 apperror 공통 응답)
+func (h *FollowHandler) writeResult(w http.ResponseWriter, r *http.Request, logTag string, result interface{}, err error) {
+	if err != nil {
+		apperror.Write(w, r, errors.Wrap(err, logTag+" failed"))
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"server/internal/apperror"
+	"server/internal/storage"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// MediaHandler 저장소 객체 서빙 (local 드라이버이거나 S3 공개 URL 을 쓰지 않을 때)
+type MediaHandler struct {
+	store storage.BlobStore
+}
+
+func NewMediaHandler(store storage.BlobStore) *MediaHandler {
+	return &MediaHandler{store: store}
+}
+
+// Serve GET /api/v1/media/{key...}
+func (h *MediaHandler) Serve(w http.ResponseWriter, r *http.Request) {
+	key := r.PathValue("key")
+	if storage.IsPrivate(key) {
+		// 내보내기 아카이브 등은 각 API 에서 소유자 확인 후 제공
+		apperror.Write(w, r, errors.Wrapf(storage.ErrObjectNotFound, "[MediaHandler.Serve] private key=%s", key))
+		return
+	}
+
+	body, info, err := h.store.Get(r.Context(), key)
+	if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[MediaHandler.Serve] get object failed, key=%s", key))
+		return
+	}
+	defer body.Close()
+
+	w.Header().Set("Content-Type", info.ContentType)
+	if info.Size > 0 {
+		w.Header().Set("Content-Length", strconv.FormatInt(info.Size, 10))
+	}
+	// 업로드마다 새 키를 쓰므로 내용이 바뀌지 않음
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if _, err := io.Copy(w, body); err != nil {
+		log.Ctx(r.Context()).Warn().Err(err).Str("key", key).Msg("[MediaHandler.Serve] copy object failed")
+	}
+}
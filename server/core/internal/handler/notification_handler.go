@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"net/http"
+	"server/internal/apperror"
+	"server/internal/pagination"
+	"server/internal/pathparam"
+	"server/internal/principal"
+	"server/internal/repository"
+	"server/internal/service"
+
+	"github.com/pkg/errors"
+)
+
+// NotificationHandler 인앱 알림함 API (/api/v1/notifications)
+type NotificationHandler struct {
+	notificationSvc *service.NotificationService
+}
+
+func NewNotificationHandler(notificationSvc *service.NotificationService) *NotificationHandler {
+	return &NotificationHandler{notificationSvc: notificationSvc}
+}
+
+// List GET /api/v1/notifications?limit=&cursor=&unread=true (최근 갱신 순)
+func (h *NotificationHandler) List(w http.ResponseWriter, r *http.Request) {
+	userID, ok := principal.UserID(r.Context())
+	if !ok {
+		apperror.Write(w, r, errNoPrincipal)
+		return
+	}
+	q := r.URL.Query()
+	page, err := pagination.ParseRequest(q, repository.NotificationSorts, "-updated_at")
+	if err != nil {
+		apperror.Write(w, r, err)
+		return
+	}
+	unreadOnly := q.Get("unread") == "true"
+
+	result, err := h.notificationSvc.List(r.Context(), userID, unreadOnly, page)
+	if errors.Is(err, pagination.ErrInvalidRequest) {
+		apperror.Write(w, r, err)
+		return
+	} else if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[NotificationHandler.List] list failed, userID=%d", userID))
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// UnreadCount GET /api/v1/notifications/unread-count → {"unread_count": n}
+func (h *NotificationHandler) UnreadCount(w http.ResponseWriter, r *http.Request) {
+	userID, ok := principal.UserID(r.Context())
+	if !ok {
+		apperror.Write(w, r, errNoPrincipal)
+		return
+	}
+	count, err := h.notificationSvc.CountUnread(r.Context(), userID)
+	if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[NotificationHandler.UnreadCount] count failed, userID=%d", userID))
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]int{"unread_count": count})
+}
+
+// MarkRead POST /api/v1/notifications/{id}/read
+func (h *NotificationHandler) MarkRead(w http.ResponseWriter, r *http.Request) {
+	userID, ok := principal.UserID(r.Context())
+	if !ok {
+		apperror.Write(w, r, errNoPrincipal)
+		return
+	}
+	notificationID, err := pathparam.ID(r, "id")
+	if err != nil {
+		apperror.Write(w, r, err)
+		return
+	}
+
+	err = h.notificationSvc.MarkRead(r.Context(), userID, notificationID)
+	if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[NotificationHandler.MarkRead] mark read failed, userID=%d", userID))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// MarkAllRead POST /api/v1/notifications/read-all → {"updated": n}
+func (h *NotificationHandler) MarkAllRead(w http.ResponseWriter, r *http.Request) {
+	userID, ok := principal.UserID(r.Context())
+	if !ok {
+		apperror.Write(w, r, errNoPrincipal)
+		return
+	}
+	updated, err := h.notificationSvc.MarkAllRead(r.Context(), userID)
+	if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[NotificationHandler.MarkAllRead] mark all read failed, userID=%d", userID))
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]int64{"updated": updated})
+}
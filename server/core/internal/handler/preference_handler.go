@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"server/internal/apperror"
+	"server/internal/principal"
+	"server/internal/service"
+
+	"github.com/pkg/errors"
+)
+
+type PreferenceHandler struct {
+	prefSvc *service.PreferenceService
+}
+
+func NewPreferenceHandler(prefSvc *service.PreferenceService) *PreferenceHandler {
+	return &PreferenceHandler{prefSvc: prefSvc}
+}
+
+// GetMine 기본값을 채운 내 설정 전체
+func (h *PreferenceHandler) GetMine(w http.ResponseWriter, r *http.Request) {
+	userID, ok := principal.UserID(r.Context())
+	if !ok {
+		apperror.Write(w, r, errNoPrincipal)
+		return
+	}
+
+	prefs, err := h.prefSvc.Get(r.Context(), userID)
+	if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[PreferenceHandler.GetMine] get preferences failed, userID=%d", userID))
+		return
+	}
+	writeJSON(w, http.StatusOK, prefs)
+}
+
+// UpdateMine 보낸 키만 변경 (null 이면 기본값으로 복귀), 변경 후 전체 설정 반환
+func (h *PreferenceHandler) UpdateMine(w http.ResponseWriter, r *http.Request) {
+	userID, ok := principal.UserID(r.Context())
+	if !ok {
+		apperror.Write(w, r, errNoPrincipal)
+		return
+	}
+
+	patch, err := Bind[map[string]json.RawMessage](r)
+	if err != nil {
+		apperror.Write(w, r, err)
+		return
+	}
+	if patch == nil {
+		apperror.Write(w, r, errInvalidBody.WithMessage("JSON 객체로 요청해주세요."))
+		return
+	}
+
+	prefs, err := h.prefSvc.Update(r.Context(), userID, patch)
+	if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[PreferenceHandler.UpdateMine] update preferences failed, userID=%d", userID))
+		return
+	}
+	writeJSON(w, http.StatusOK, prefs)
+}
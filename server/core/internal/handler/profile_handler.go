@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"net/http"
+	"net/url"
+	"server/internal/apperror"
+	"server/internal/principal"
+	"server/internal/service"
+
+	"github.com/pkg/errors"
+)
+
+// ChangeHandleRequest PATCH /api/v1/users/me/handle
+// 형식 검증은 정규화(@ 제거, 소문자) 후 ProfileService.ChangeHandle 에서
+type ChangeHandleRequest struct {
+	Handle string `json:"handle" validate:"required"`
+}
+
+// ProfileHandler 공개 프로필 API (/api/v1/profiles/{handle}, /api/v1/users/me/handle)
+type ProfileHandler struct {
+	profileSvc *service.ProfileService
+	userSvc    *service.UserService
+}
+
+func NewProfileHandler(profileSvc *service.ProfileService, userSvc *service.UserService) *ProfileHandler {
+	return &ProfileHandler{profileSvc: profileSvc, userSvc: userSvc}
+}
+
+// GetProfile GET /api/v1/profiles/{handle} (비로그인 가능)
+// 예전 handle 이면 현재 handle 주소로 301 리다이렉트 (공개 범위 확인은 ProfileService.GetPublicProfile)
+func (h *ProfileHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
+	viewerID, _ := principal.UserID(r.Context())
+	handle := r.PathValue("handle")
+
+	profile, err := h.profileSvc.GetPublicProfile(r.Context(), handle, viewerID)
+	if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[GetProfile] get public profile failed, handle=%s", handle))
+		return
+	}
+
+	if profile.RedirectHandle != "" {
+		http.Redirect(w, r, "/api/v1/profiles/"+url.PathEscape(profile.RedirectHandle), http.StatusMovedPermanently)
+		return
+	}
+	writeJSON(w, http.StatusOK, profile.Fields)
+}
+
+// ChangeHandle PATCH /api/v1/users/me/handle {"handle": "..."}
+// 30일에 한 번만 변경 가능, 이전 handle 은 180일 동안 예약되어 다른 유저가 사용할 수 없고 공개 프로필 조회 시 새 handle 로 리다이렉트됨
+func (h *ProfileHandler) ChangeHandle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := principal.UserID(r.Context())
+	if !ok {
+		apperror.Write(w, r, errNoPrincipal)
+		return
+	}
+
+	req, err := Bind[ChangeHandleRequest](r)
+	if err != nil {
+		apperror.Write(w, r, err)
+		return
+	}
+
+	user, err := h.profileSvc.ChangeHandle(r.Context(), userID, req.Handle)
+	if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[ChangeHandle] change handle failed, userID=%d", userID))
+		return
+	}
+	profile, err := h.userSvc.Profile(r.Context(), user)
+	if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[ChangeHandle] get profile failed, userID=%d", userID))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toProfileResponse(profile))
+}
@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"server/internal/apperror"
+)
+
+var (
+	// errNoPrincipal 인증 미들웨어를 거쳤는데 context 에 Principal 이 없는 경우
+	errNoPrincipal = apperror.Unauthorized("", "")
+	// errInvalidBody JSON 본문을 해석할 수 없는 경우
+	errInvalidBody = apperror.BadRequest("INVALID_BODY", "요청 본문을 해석할 수 없습니다.")
+	// errInvalidQuery 쿼리 파라미터 형식 오류 (details.param 에 파라미터 이름)
+	errInvalidQuery = apperror.BadRequest("INVALID_QUERY_PARAMETER", "쿼리 파라미터가 올바르지 않습니다.")
+)
+
+// writeJSON 성공 응답 (에러 응답은 apperror.Write)
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
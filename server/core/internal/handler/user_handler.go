@@ -1,73 +1,248 @@
 package handler
 
 import (
-	"encoding/json"
 	"net/http"
+	"server/internal/apperror"
+	"server/internal/model"
+	"server/internal/pagination"
+	"server/internal/principal"
+	"server/internal/repository"
 	"server/internal/service"
+	"strconv"
+	"time"
 
-	"github.com/rs/zerolog/log"
+	"github.com/pkg/errors"
 )
 
+// errProfileModified updated_at 이 달라 다른 요청이 먼저 프로필을 수정한 경우 (다시 불러와서 재시도)
+var errProfileModified = repository.ErrConflict.WithMessage("다른 요청에서 프로필이 먼저 수정되었습니다. 새로고침 후 다시 시도해주세요.")
+
+// CreateUserRequest POST /api/v1/users
+type CreateUserRequest struct {
+	Username string `json:"username" validate:"required,nickname"`
+}
+
+// UpdateMeRequest PATCH /api/v1/users/me (보내지 않은 필드는 유지)
+// 필드별 규칙 검증은 UserService.UpdateProfile 에서 (null 과 빈 문자열 구분 필요)
+type UpdateMeRequest struct {
+	Nickname    *string    `json:"nickname,omitempty"`
+	DisplayName *string    `json:"display_name,omitempty"`
+	Bio         *string    `json:"bio,omitempty"`
+	Locale      *string    `json:"locale,omitempty"`     // 설정(preference) locale 과 같은 값
+	Timezone    *string    `json:"timezone,omitempty"`   // 설정(preference) timezone 과 같은 값
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"` // 마지막으로 받은 updated_at (다르면 409)
+}
+
 type UserHandler struct {
-	userSvc *service.UserService
+	userSvc     *service.UserService
+	activitySvc *service.ActivityService
+	followSvc   *service.FollowService
 }
 
-func NewUserHandler(svc *service.UserService) *UserHandler {
-	return &UserHandler{userSvc: svc}
+func NewUserHandler(svc *service.UserService, activitySvc *service.ActivityService, followSvc *service.FollowService) *UserHandler {
+	return &UserHandler{userSvc: svc, activitySvc: activitySvc, followSvc: followSvc}
 }
 
+// ListUsers GET /api/v1/users?limit=&cursor=&sort=&provider=&role=&created_from=&created_to=&email_prefix=&nickname_prefix=
+// 이메일/실명/정지 사유까지 전체 필드를 응답하므로 관리자 전용 (일반 유저는 SearchUsers)
 func (h *UserHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
-	users, err := h.userSvc.ListUsers(r.Context())
+	q := r.URL.Query()
+	page, err := pagination.ParseRequest(q, repository.UserSorts, "-created_at")
 	if err != nil {
-		log.Error().Err(err).Msg("[UserHandler] failed to list users")
-		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		apperror.Write(w, r, err)
 		return
 	}
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(users)
+
+	filter := model.UserFilter{
+		OauthProvider:  q.Get("provider"),
+		Role:           q.Get("role"),
+		EmailPrefix:    q.Get("email_prefix"),
+		NicknamePrefix: q.Get("nickname_prefix"),
+	}
+	for param, dst := range map[string]**time.Time{
+		"created_from": &filter.CreatedFrom,
+		"created_to":   &filter.CreatedTo,
+	} {
+		v := q.Get(param)
+		if v == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			apperror.Write(w, r, errInvalidQuery.WithDetail("param", param).Wrap(err))
+			return
+		}
+		// created_at 은 TIMESTAMP(UTC) 컬럼이라 pgx 가 오프셋을 버리기 전에 UTC 로 변환
+		t = t.UTC()
+		*dst = &t
+	}
+
+	users, err := h.userSvc.ListUsers(r.Context(), filter, page)
+	if err != nil {
+		apperror.Write(w, r, errors.Wrap(err, "[UserHandler] failed to list users"))
+		return
+	}
+	writeJSON(w, http.StatusOK, users)
 }
 
 func (h *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
-	var req struct {
-		Username string `json:"username"`
-	}
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Bad Request", http.StatusBadRequest)
+	req, err := Bind[CreateUserRequest](r)
+	if err != nil {
+		apperror.Write(w, r, err)
 		return
 	}
 
 	user, err := h.userSvc.CreateUser(r.Context(), req.Username)
 	if err != nil {
-		log.Error().Err(err).
-			Str("username", req.Username).
-			Msg("[UserHandler] failed to create user")
-		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		apperror.Write(w, r, errors.Wrapf(err, "[UserHandler] failed to create user, username=%s", req.Username))
 		return
 	}
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(user)
+	writeJSON(w, http.StatusOK, user)
 }
 
 func (h *UserHandler) HandleMe(w http.ResponseWriter, r *http.Request) {
-	// AuthMiddleware 에서 "userID"를 context 에 넣어 줌
-	userID, ok := r.Context().Value("userID").(int)
-	if !ok || userID <= 0 {
-		http.Error(w, "Unauthorized (invalid context)", http.StatusUnauthorized)
+	// AuthMiddleware 에서 Principal 을 context 에 넣어 줌
+	userID, ok := principal.UserID(r.Context())
+	if !ok {
+		apperror.Write(w, r, errNoPrincipal)
 		return
 	}
 
-	user, err := h.userSvc.FindByID(r.Context(), userID)
+	profile, err := h.userSvc.GetProfile(r.Context(), userID)
 	if err != nil {
-		log.Error().Err(err).Msgf("[HandleMe] cannot find user by ID=%d", userID)
-		http.Error(w, "User not found", http.StatusNotFound)
+		apperror.Write(w, r, errors.Wrapf(err, "[HandleMe] cannot find user by ID=%d", userID))
+		return
+	}
+	counts, err := h.followSvc.Counts(r.Context(), userID)
+	if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[HandleMe] cannot count follows, userID=%d", userID))
+		return
+	}
+
+	resp := toProfileResponse(profile)
+	resp["followers_count"] = counts.Followers
+	resp["following_count"] = counts.Following
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// UpdateMe 프로필 부분 수정 (보내지 않은 필드는 유지)
+func (h *UserHandler) UpdateMe(w http.ResponseWriter, r *http.Request) {
+	userID, ok := principal.UserID(r.Context())
+	if !ok {
+		apperror.Write(w, r, errNoPrincipal)
 		return
 	}
 
-	resp := map[string]interface{}{
-		"id":    user.ID,
-		"name":  user.Nickname, // 예) nickname 을 클라이언트에 name 으로 내려줌
-		"email": user.Email,
+	req, err := Bind[UpdateMeRequest](r)
+	if err != nil {
+		apperror.Write(w, r, err)
+		return
 	}
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(resp)
+
+	profile, err := h.userSvc.UpdateProfile(r.Context(), userID, service.ProfileUpdate{
+		Nickname:          req.Nickname,
+		DisplayName:       req.DisplayName,
+		Bio:               req.Bio,
+		Locale:            req.Locale,
+		Timezone:          req.Timezone,
+		ExpectedUpdatedAt: req.UpdatedAt,
+	})
+	if errors.Is(err, repository.ErrConflict) {
+		apperror.Write(w, r, errProfileModified.Wrap(err))
+		return
+	} else if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[UpdateMe] update profile failed, userID=%d", userID))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toProfileResponse(profile))
+}
+
+// CompleteOnboarding POST /api/v1/users/me/onboarding 첫 로그인 온보딩 완료 (여러 번 호출해도 최초 시각 유지)
+func (h *UserHandler) CompleteOnboarding(w http.ResponseWriter, r *http.Request) {
+	userID, ok := principal.UserID(r.Context())
+	if !ok {
+		apperror.Write(w, r, errNoPrincipal)
+		return
+	}
+
+	if err := h.activitySvc.CompleteOnboarding(r.Context(), userID); err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[CompleteOnboarding] complete onboarding failed, userID=%d", userID))
+		return
+	}
+	profile, err := h.userSvc.GetProfile(r.Context(), userID)
+	if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[CompleteOnboarding] cannot find user by ID=%d", userID))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toProfileResponse(profile))
+}
+
+// toProfileResponse 본인 프로필 응답 (name 은 기존 클라이언트 호환을 위해 nickname 유지)
+func toProfileResponse(user *service.UserProfile) map[string]interface{} {
+	return map[string]interface{}{
+		"id":            user.ID,
+		"name":          user.Nickname,
+		"email":         user.Email,
+		"nickname":      user.Nickname,
+		"handle":        user.Handle,
+		"display_name":  user.DisplayName,
+		"bio":           user.Bio,
+		"locale":        user.Locale,
+		"timezone":      user.Timezone,
+		"profile_image": user.ProfileImage,
+		"updated_at":    user.UpdatedAt,
+
+		"last_login_at":    user.LastLoginAt,
+		"needs_onboarding": user.NeedsOnboarding(),
+	}
+}
+
+// SearchUsers GET /api/v1/users/search?q=&limit=
+// 관리자가 아니면 공개 필드만 검색/응답 (이메일·실명으로 다른 유저를 찾을 수 없음)
+func (h *UserHandler) SearchUsers(w http.ResponseWriter, r *http.Request) {
+	p, ok := principal.FromContext(r.Context())
+	if !ok {
+		apperror.Write(w, r, errNoPrincipal)
+		return
+	}
+	isAdmin := p.HasRole(model.RoleAdmin)
+
+	limit := 0
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			apperror.Write(w, r, errInvalidQuery.WithDetail("param", "limit"))
+			return
+		}
+		limit = n
+	}
+
+	hits, err := h.userSvc.SearchUsers(r.Context(), p.UserID, r.URL.Query().Get("q"), isAdmin, limit)
+	if err != nil {
+		apperror.Write(w, r, errors.Wrap(err, "[SearchUsers] search users failed"))
+		return
+	}
+
+	items := make([]map[string]interface{}, 0, len(hits))
+	for _, hit := range hits {
+		item := map[string]interface{}{
+			"id":            hit.User.ID,
+			"nickname":      hit.User.Nickname,
+			"display_name":  hit.User.DisplayName,
+			"profile_image": hit.User.ProfileImage,
+			"score":         hit.Score,
+			"highlights":    hit.Highlights,
+		}
+		if isAdmin {
+			item["name"] = hit.User.Name
+			item["email"] = hit.User.Email
+			item["role"] = hit.User.Role
+			item["created_at"] = hit.User.CreatedAt
+		}
+		items = append(items, item)
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"items": items})
 }
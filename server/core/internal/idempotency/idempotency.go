@@ -0,0 +1,83 @@
+// Package idempotency Idempotency-Key 헤더로 재시도한 요청의 중복 처리 방지
+//
+// 처음 요청이 key 를 선점(처리 중)하고 응답을 저장하면, 같은 key 의 재시도에는 저장한 응답을 재전송
+// 처리 중인 key 로 동시에 들어온 요청은 409, 같은 key 를 다른 요청(fingerprint)에 쓰면 422
+package idempotency
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const cleanupInterval = 10 * time.Minute
+
+// ErrNotOwner 처리하는 동안 잠금이 풀려 다른 요청이 key 를 넘겨받음 (이 요청의 응답은 저장하지 않음)
+var ErrNotOwner = errors.New("idempotency key taken over by another attempt")
+
+// Response 재전송할 응답
+type Response struct {
+	Status int
+	Header http.Header
+	Body   []byte
+}
+
+// Record 이미 선점된 key 의 상태
+type Record struct {
+	Fingerprint string
+	Response    *Response // nil 이면 처리 중
+}
+
+// Store key 저장소 (ECS 태스크 간 공유해야 하므로 postgres)
+//
+// 잠금이 풀린 key 는 재시도가 새 attemptID 로 넘겨받으므로, Complete/Release 는 자기 attemptID 로 선점한 상태일 때만 반영
+type Store interface {
+	// Begin attemptID 로 key 선점 (새 key, 만료된 key, 잠금이 풀린 처리 중 key 면 acquired=true)
+	// 선점하지 못하면 기존 기록 반환
+	Begin(ctx context.Context, key, attemptID, fingerprint string, lockTimeout, ttl time.Duration) (rec Record, acquired bool, err error)
+	// Complete 처리 중인 key 에 응답 저장
+	Complete(ctx context.Context, key, attemptID string, resp Response) error
+	// Release 처리 중인 key 삭제 (실패한 요청은 같은 key 로 다시 처리 가능)
+	Release(ctx context.Context, key, attemptID string) error
+	// Cleanup 보관 기간이 지난 key 삭제
+	Cleanup(ctx context.Context) error
+}
+
+// Fingerprint 같은 key 로 다른 요청을 보냈는지 구분하는 값 (메서드, 경로+쿼리, 본문)
+func Fingerprint(method, target string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method + " " + target + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// NewAttemptID 요청마다 새로 만드는 선점 식별자
+func NewAttemptID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// RunCleanupLoop 만료된 key 를 주기적으로 삭제 (ctx 취소 시 종료)
+func RunCleanupLoop(ctx context.Context, store Store) {
+	ticker := time.NewTicker(cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("[idempotency.RunCleanupLoop] stopped")
+			return
+		case <-ticker.C:
+			if err := store.Cleanup(ctx); err != nil {
+				log.Error().Err(err).Msg("[idempotency.RunCleanupLoop] cleanup failed")
+			}
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"server/internal/db"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+// PostgresStore idempotency_keys 테이블에 key 와 응답 저장
+// 시각 계산은 모두 DB 의 NOW() 기준이라 인스턴스 간 시계 차이의 영향을 받지 않음
+type PostgresStore struct {
+	db *db.DB
+}
+
+func NewPostgresStore(dbConn *db.DB) *PostgresStore {
+	return &PostgresStore{db: dbConn}
+}
+
+func (s *PostgresStore) Begin(ctx context.Context, key, attemptID, fingerprint string, lockTimeout, ttl time.Duration) (Record, bool, error) {
+	// 만료된 key 또는 잠금이 풀린 같은 요청의 처리 중 key 만 넘겨받음 (그 외에는 WHERE 에 걸려 반환 행 없음)
+	var acquired bool
+	err := s.db.Pool.QueryRow(ctx, `
+		INSERT INTO idempotency_keys AS k (key, attempt_id, fingerprint, locked_until, expires_at)
+		     VALUES ($1, $5, $2, NOW() + make_interval(secs => $3), NOW() + make_interval(secs => $4))
+		ON CONFLICT (key) DO UPDATE
+		        SET attempt_id      = EXCLUDED.attempt_id,
+		            fingerprint     = EXCLUDED.fingerprint,
+		            status_code     = NULL,
+		            response_header = NULL,
+		            response_body   = NULL,
+		            locked_until    = EXCLUDED.locked_until,
+		            expires_at      = EXCLUDED.expires_at,
+		            created_at      = NOW()
+		      WHERE k.expires_at < NOW()
+		         OR (k.status_code IS NULL AND k.locked_until < NOW() AND k.fingerprint = EXCLUDED.fingerprint)
+		  RETURNING TRUE
+	`, key, fingerprint, lockTimeout.Seconds(), ttl.Seconds(), attemptID).Scan(&acquired)
+	if err == nil {
+		return Record{}, true, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return Record{}, false, errors.Wrapf(err, "[PostgresStore.Begin] insert key failed, key=%s", key)
+	}
+
+	var (
+		rec    Record
+		status *int
+		header http.Header
+		body   []byte
+	)
+	err = s.db.Pool.QueryRow(ctx, `
+		SELECT fingerprint, status_code, response_header, response_body
+		  FROM idempotency_keys
+		 WHERE key = $1
+	`, key).Scan(&rec.Fingerprint, &status, &header, &body)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// 그 사이 처리하던 요청이 실패해 key 가 삭제됨 → 처리 중으로 응답 (재시도하면 선점 가능)
+		return Record{Fingerprint: fingerprint}, false, nil
+	}
+	if err != nil {
+		return Record{}, false, errors.Wrapf(err, "[PostgresStore.Begin] select key failed, key=%s", key)
+	}
+	if status != nil {
+		rec.Response = &Response{Status: *status, Header: header, Body: body}
+	}
+	return rec, false, nil
+}
+
+// Complete 다른 요청이 key 를 넘겨받았으면 ErrNotOwner
+func (s *PostgresStore) Complete(ctx context.Context, key, attemptID string, resp Response) error {
+	tag, err := s.db.Pool.Exec(ctx, `
+		UPDATE idempotency_keys
+		   SET status_code = $3, response_header = $4, response_body = $5
+		 WHERE key = $1 AND attempt_id = $2 AND status_code IS NULL
+	`, key, attemptID, resp.Status, resp.Header, resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "[PostgresStore.Complete] update key failed, key=%s", key)
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Wrapf(ErrNotOwner, "[PostgresStore.Complete] key=%s", key)
+	}
+	return nil
+}
+
+// Release 다른 요청이 key 를 넘겨받았으면 아무것도 하지 않음
+func (s *PostgresStore) Release(ctx context.Context, key, attemptID string) error {
+	if _, err := s.db.Pool.Exec(ctx, `
+		DELETE FROM idempotency_keys
+		 WHERE key = $1 AND attempt_id = $2 AND status_code IS NULL
+	`, key, attemptID); err != nil {
+		return errors.Wrapf(err, "[PostgresStore.Release] delete key failed, key=%s", key)
+	}
+	return nil
+}
+
+func (s *PostgresStore) Cleanup(ctx context.Context) error {
+	if _, err := s.db.Pool.Exec(ctx, `DELETE FROM idempotency_keys WHERE expires_at < NOW()`); err != nil {
+		return errors.Wrap(err, "[PostgresStore.Cleanup] delete expired keys failed")
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package job
+
+import (
+	"context"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Task 백그라운드에서 실행할 작업 (ctx 는 Queue 종료 시 취소됨)
+type Task func(ctx context.Context) error
+
+type item struct {
+	name string
+	task Task
+}
+
+// Queue 고정 개수 워커로 작업을 처리하는 인메모리 작업 큐
+// 작업 상태는 각 도메인 테이블(data_exports 등)에 남기고, 재시작 시 도메인 서비스가 미완료 작업을 다시 넣는 방식으로 사용
+type Queue struct {
+	items   chan item
+	workers int
+	timeout time.Duration
+
+	wg     sync.WaitGroup
+	cancel context.CancelFunc
+}
+
+// NewQueue workers: 동시 실행 수, buffer: 대기열 크기, timeout: 작업 1건당 최대 실행 시간
+func NewQueue(workers, buffer int, timeout time.Duration) *Queue {
+	if workers <= 0 {
+		workers = 1
+	}
+	return &Queue{
+		items:   make(chan item, buffer),
+		workers: workers,
+		timeout: timeout,
+	}
+}
+
+// Start 워커 고루틴 시작 (ctx 취소 또는 Stop 호출 시 종료)
+func (q *Queue) Start(ctx context.Context) {
+	ctx, q.cancel = context.WithCancel(ctx)
+	for i := 0; i < q.workers; i++ {
+		q.wg.Add(1)
+		go q.work(ctx)
+	}
+}
+
+// Stop 워커 종료 후 실행 중인 작업이 끝날 때까지 대기
+func (q *Queue) Stop() {
+	if q.cancel != nil {
+		q.cancel()
+	}
+	q.wg.Wait()
+}
+
+// Enqueue 작업 추가 (대기열이 가득 차면 false → 호출 측에서 나중에 재시도)
+func (q *Queue) Enqueue(name string, task Task) bool {
+	select {
+	case q.items <- item{name: name, task: task}:
+		return true
+	default:
+		log.Warn().Str("job", name).Msg("[Queue.Enqueue] queue is full, job dropped")
+		return false
+	}
+}
+
+func (q *Queue) work(ctx context.Context) {
+	defer q.wg.Done()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case it := <-q.items:
+			q.run(ctx, it)
+		}
+	}
+}
+
+// run panic 이 워커를 죽이지 않도록 recover
+func (q *Queue) run(ctx context.Context, it item) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			log.Error().
+				Str("job", it.name).
+				Interface("panic", rec).
+				Str("stack", string(debug.Stack())).
+				Msg("[Queue.run] job panicked")
+		}
+	}()
+
+	if q.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, q.timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	if err := it.task(ctx); err != nil {
+		log.Error().Err(err).Str("job", it.name).Dur("elapsed", time.Since(start)).Msg("[Queue.run] job failed")
+		return
+	}
+	log.Info().Str("job", it.name).Dur("elapsed", time.Since(start)).Msg("[Queue.run] job completed")
+}
@@ -0,0 +1,126 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"fmt"
+	"net/http"
+	"server/internal/clientip"
+	"server/internal/requestid"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// 클라이언트가 보낸 X-Request-ID 최대 길이 (넘거나 허용하지 않는 문자가 있으면 새로 발급)
+const maxRequestIDLen = 128
+
+// 헬스 체크는 ALB 가 수 초마다 호출하므로 debug 레벨로 기록
+var quietPaths = map[string]bool{
+	"/api/v1/health": true,
+}
+
+// NewAccessLogMiddleware 가장 바깥에서 실행: 요청 ID 발급/전달, 요청 로거를 context 에 넣고 요청마다 접근 로그 한 줄
+//
+// 이후 코드는 log.Ctx(ctx) 로 로그를 남기면 request_id 가 자동으로 붙고,
+// 인증 후에는 user_id, 라우팅 후에는 route 도 붙음 (logger.UpdateContext)
+// client_ip 는 trustedProxies 개의 프록시를 거친 것으로 보고 계산 (clientip.Behind)
+func NewAccessLogMiddleware(trustedProxies int) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			id := r.Header.Get(requestid.Header)
+			if !validRequestID(id) {
+				id = newRequestID()
+			}
+			w.Header().Set(requestid.Header, id)
+
+			reqLogger := log.With().Str("request_id", id).Logger()
+			ctx := requestid.NewContext(r.Context(), id)
+			ctx = reqLogger.WithContext(ctx)
+
+			rec := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(rec, r.WithContext(ctx))
+
+			// WithContext 는 로거 사본을 저장하므로 user_id, route 가 추가된 context 쪽 로거로 기록
+			reqLogger = *zerolog.Ctx(ctx)
+			var event *zerolog.Event
+			switch {
+			case rec.status >= http.StatusInternalServerError:
+				event = reqLogger.Error()
+			case quietPaths[r.URL.Path]:
+				event = reqLogger.Debug()
+			default:
+				event = reqLogger.Info()
+			}
+			event.
+				Str("method", r.Method).
+				Str("path", r.URL.Path).
+				Int("status", rec.status).
+				Int64("bytes", rec.bytes).
+				Dur("latency", time.Since(start)).
+				Str("client_ip", clientip.Behind(r, trustedProxies)).
+				Str("user_agent", r.UserAgent()).
+				Msg("[AccessLog] request completed")
+		})
+	}
+}
+
+// responseRecorder 접근 로그용 응답 상태 코드와 본문 크기 기록
+type responseRecorder struct {
+	http.ResponseWriter
+	status      int
+	bytes       int64
+	wroteHeader bool
+}
+
+func (rw *responseRecorder) WriteHeader(status int) {
+	if !rw.wroteHeader {
+		rw.status = status
+		rw.wroteHeader = true
+	}
+	rw.ResponseWriter.WriteHeader(status)
+}
+
+func (rw *responseRecorder) Write(b []byte) (int, error) {
+	rw.wroteHeader = true
+	n, err := rw.ResponseWriter.Write(b)
+	rw.bytes += int64(n)
+	return n, err
+}
+
+// Unwrap http.ResponseController 가 원래 ResponseWriter 의 Flush 등을 사용할 수 있게
+func (rw *responseRecorder) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
+func (rw *responseRecorder) Flush() {
+	if f, ok := rw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLen {
+		return false
+	}
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case c == '-' || c == '_' || c == '.' || c == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// newRequestID UUID v4 형식
+func newRequestID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
@@ -3,208 +3,317 @@ package middleware
 import (
 	"context"
 	"net/http"
-	"os"
-	"server/internal/flags"
+	"server/internal/apperror"
+	"server/internal/cookie"
+	"server/internal/model"
+	"server/internal/principal"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"server/internal/repository"
 	"server/internal/service"
+	"server/pkg/logger"
 )
 
+// ConsentRequiredCode 새 필수 약관 버전에 동의해야 할 때 403 응답의 code (프론트에서 동의 화면으로 분기)
+const ConsentRequiredCode = "CONSENT_REQUIRED"
+
+var (
+	// errUnauthenticated 자격 증명이 없거나 유효하지 않음 (details.reason 에 사유)
+	errUnauthenticated = apperror.Unauthorized("", "")
+	// errConsentRequired details.pending_documents 에 동의가 필요한 문서 목록
+	errConsentRequired = apperror.Forbidden(ConsentRequiredCode, "새로운 필수 약관에 동의가 필요합니다.")
+)
+
+// ConsentChecker 필수 약관 동의 여부 확인 (service.ConsentService 가 구현)
+type ConsentChecker interface {
+	PendingMandatoryDocuments(ctx context.Context, userID int) ([]model.LegalDocument, error)
+}
+
+// AccountStatusChecker 정지/차단 여부와 최신 역할 확인 (service.AdminService 가 구현)
+type AccountStatusChecker interface {
+	AccountStatus(ctx context.Context, userID int) (*model.AccountStatus, error)
+}
+
+// ActivityTracker 인증된 요청마다 last_seen_at/일일 활동 기록 (service.ActivityService 가 구현, 내부에서 스로틀링)
+type ActivityTracker interface {
+	Touch(userID int)
+}
+
 type AuthMiddleware struct {
 	jwtManager       *service.JWTManager
 	refreshTokenRepo repository.RefreshTokenRepository
 	userRepo         repository.UserRepository
+	consentChecker   ConsentChecker
+	statusChecker    AccountStatusChecker
+	activityTracker  ActivityTracker
+	rateLimit        *RateLimitMiddleware
+	cookies          *cookie.Policy
 }
 
 func NewAuthMiddleware(
 	jwtManager *service.JWTManager,
 	refreshRepo repository.RefreshTokenRepository,
 	userRepo repository.UserRepository,
+	consentChecker ConsentChecker,
+	statusChecker AccountStatusChecker,
+	activityTracker ActivityTracker,
+	rateLimit *RateLimitMiddleware,
+	cookies *cookie.Policy,
 ) *AuthMiddleware {
 	return &AuthMiddleware{
 		jwtManager:       jwtManager,
 		refreshTokenRepo: refreshRepo,
 		userRepo:         userRepo,
+		consentChecker:   consentChecker,
+		statusChecker:    statusChecker,
+		activityTracker:  activityTracker,
+		rateLimit:        rateLimit,
+		cookies:          cookies,
 	}
 }
 
+// Handle 인증 필수 모드: 유효한 access_token(또는 재발급 가능한 refresh_token)이 없으면 401,
+// 정지/차단된 계정이면 403 (code=ACCOUNT_SUSPENDED / ACCOUNT_BANNED),
+// 아직 동의하지 않은 필수 약관이 있으면 403 (code=CONSENT_REQUIRED)
 func (m *AuthMiddleware) Handle(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		log.Info().Msgf("[AuthMiddleware] Incoming request: method=%s, url=%s", r.Method, r.URL.String())
+	return m.HandleSkipConsent(m.RequireConsent(next))
+}
 
-		// 1) 쿠키에서 access_token, refresh_token 읽기
-		accessCookie, err := r.Cookie("access_token")
+// HandleSkipConsent 인증만 확인하고 약관 동의 여부는 검사하지 않음
+// 약관 동의 API 자체, 회원 탈퇴, 개인정보 내보내기처럼 미동의 상태에서도 호출할 수 있어야 하는 라우트용
+// 라우트 그룹 전체에 걸고, 동의가 필요한 하위 그룹에만 RequireConsent 를 추가하는 식으로 사용
+func (m *AuthMiddleware) HandleSkipConsent(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p, err := m.authenticate(w, r)
 		if err != nil {
-			log.Warn().Err(err).Msg("[AuthMiddleware] Missing access_token cookie")
-			// access_token 쿠키가 없으면 refresh_token으로 재발급 시도
-			refreshCookie, err := r.Cookie("refresh_token")
-			if err != nil {
-				log.Error().Msg("[AuthMiddleware] Missing refresh_token cookie as well")
-				http.Error(w, "Unauthorized (no tokens)", http.StatusUnauthorized)
-				return
-			}
-			log.Info().Msgf("[AuthMiddleware] Found refresh_token cookie, value=%s", refreshCookie.Value)
-			log.Info().Msg("[AuthMiddleware] Attempting to reissue access token using refresh token")
-			newToken, reissueErr := m.tryReissueAccessToken(w, refreshCookie.Value)
-			if reissueErr != nil {
-				log.Error().Err(reissueErr).Msg("[AuthMiddleware] Failed to reissue access token")
-				http.Error(w, "Unauthorized (refresh token invalid)", http.StatusUnauthorized)
-				return
-			}
-			log.Info().Msg("[AuthMiddleware] Access token reissued successfully")
-			log.Info().Msgf("[AuthMiddleware] Raw token (reissued): %s", newToken.Raw)
-			// 새로 재발급한 토큰에서 사용자 정보를 추출하여 context에 추가
-			claims, ok := newToken.Claims.(service.MapClaimsWithSubID)
-			if !ok {
-				log.Error().Msgf("[AuthMiddleware] Invalid token claims after reissue, claims type=%T, raw claims=%+v", newToken.Claims, newToken.Claims)
-				http.Error(w, "Unauthorized (invalid token claims)", http.StatusUnauthorized)
+			var limited *rateLimitedError
+			if errors.As(err, &limited) {
+				limited.write(w, r)
 				return
 			}
-			userID := claims.GetUserID()
-			log.Info().Msgf("[AuthMiddleware] Extracted userID from reissued token claims: %d", userID)
-			if userID == 0 {
-				log.Error().Msgf("[AuthMiddleware] No userID found in token claims after reissue, raw claims=%+v", newToken.Claims)
-				http.Error(w, "Unauthorized (no user in token)", http.StatusUnauthorized)
+			var failure *authFailure
+			if errors.As(err, &failure) {
+				log.Ctx(r.Context()).Warn().Err(failure.cause).Str("reason", failure.reason).Msg("[AuthMiddleware] Unauthorized")
+				apperror.Write(w, r, errUnauthenticated.WithDetail("reason", failure.reason))
 				return
 			}
-			ctx := context.WithValue(r.Context(), "userID", userID)
-			r = r.WithContext(ctx)
-			next.ServeHTTP(w, r)
+			log.Ctx(r.Context()).Error().Err(err).Msg("[AuthMiddleware] Unexpected authentication error")
+			apperror.Write(w, r, errUnauthenticated)
 			return
 		}
 
-		log.Info().Msgf("[AuthMiddleware] Access token cookie found, value=%s", accessCookie.Value)
+		withUser(r.Context(), p)
+		log.Ctx(r.Context()).Debug().Str("auth_method", string(p.AuthMethod)).Msg("[AuthMiddleware] Authenticated")
 
-		// 2) Access Token 검증
-		token, parseErr := m.jwtManager.VerifyToken(accessCookie.Value)
-		if parseErr != nil {
-			log.Warn().Err(parseErr).Msg("[AuthMiddleware] Access token verification failed, attempting reissue using refresh token")
-			refreshCookie, err := r.Cookie("refresh_token")
-			if err != nil {
-				log.Error().Msg("[AuthMiddleware] Missing refresh_token cookie")
-				http.Error(w, "Unauthorized (no refresh token)", http.StatusUnauthorized)
-				return
-			}
-			log.Info().Msgf("[AuthMiddleware] Found refresh_token cookie, value=%s", refreshCookie.Value)
-			newToken, reissueErr := m.tryReissueAccessToken(w, refreshCookie.Value)
-			if reissueErr != nil {
-				log.Error().Err(reissueErr).Msg("[AuthMiddleware] Failed to reissue access token")
-				http.Error(w, "Unauthorized (refresh token invalid)", http.StatusUnauthorized)
-				return
-			}
-			log.Info().Msg("[AuthMiddleware] Access token reissued successfully")
-			log.Info().Msgf("[AuthMiddleware] Raw token (reissued): %s", newToken.Raw)
-			claims, ok := newToken.Claims.(service.MapClaimsWithSubID)
-			if !ok {
-				log.Error().Msgf("[AuthMiddleware] Invalid token claims after reissue, claims type=%T, raw claims=%+v", newToken.Claims, newToken.Claims)
-				http.Error(w, "Unauthorized (invalid token claims)", http.StatusUnauthorized)
-				return
-			}
-			userID := claims.GetUserID()
-			log.Info().Msgf("[AuthMiddleware] Extracted userID from reissued token claims: %d", userID)
-			if userID == 0 {
-				log.Error().Msgf("[AuthMiddleware] No userID found in token claims after reissue, raw claims=%+v", newToken.Claims)
-				http.Error(w, "Unauthorized (no user in token)", http.StatusUnauthorized)
-				return
-			}
-			ctx := context.WithValue(r.Context(), "userID", userID)
-			r = r.WithContext(ctx)
-			next.ServeHTTP(w, r)
+		if !m.checkAccountStatus(w, r, p) {
 			return
 		}
+		m.activityTracker.Touch(p.UserID)
+		next.ServeHTTP(w, r.WithContext(principal.NewContext(r.Context(), p)))
+	})
+}
 
-		if !token.Valid {
-			log.Warn().Msg("[AuthMiddleware] Token is not valid")
-			http.Error(w, "Unauthorized (invalid token)", http.StatusUnauthorized)
+// RequireConsent HandleSkipConsent 뒤에서 사용: 아직 동의하지 않은 필수 약관이 있으면 403 (code=CONSENT_REQUIRED)
+func (m *AuthMiddleware) RequireConsent(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := principal.UserID(r.Context())
+		if !ok {
+			apperror.Write(w, r, errUnauthenticated)
 			return
 		}
+		if !m.checkConsent(w, r, userID) {
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
 
-		// 로깅: 검증된 토큰의 원시 클레임 내용
-		log.Info().Msgf("[AuthMiddleware] Raw token claims: %+v", token.Claims)
+// checkAccountStatus 정지/차단된 계정이면 403, 탈퇴한 계정이면 401 응답 후 false 반환
+// 토큰의 role 은 발급 시점 값이므로 최신 역할로 덮어씀
+func (m *AuthMiddleware) checkAccountStatus(w http.ResponseWriter, r *http.Request, p *principal.Principal) bool {
+	status, err := m.statusChecker.AccountStatus(r.Context(), p.UserID)
+	if err != nil {
+		log.Ctx(r.Context()).Error().Err(err).Msg("[AuthMiddleware] Failed to check account status")
+		apperror.Write(w, r, errUnauthenticated)
+		return false
+	}
+	p.Role = status.Role
 
-		// 3) 토큰 클레임에서 userID 추출 → Context에 저장
-		claims, ok := token.Claims.(service.MapClaimsWithSubID)
-		if !ok {
-			log.Error().Msgf("[AuthMiddleware] Failed to extract claims from token, claims type=%T, raw claims=%+v", token.Claims, token.Claims)
-			http.Error(w, "Unauthorized (invalid claims)", http.StatusUnauthorized)
+	code := status.BlockCode(time.Now())
+	if code == "" {
+		return true
+	}
+
+	log.Ctx(r.Context()).Info().Str("code", code).Msg("[AuthMiddleware] Blocked account")
+	if code == model.AccountDeletedCode {
+		// 탈퇴 시 세션은 모두 폐기되므로 남은 access_token 도 로그인하지 않은 것으로 취급
+		apperror.Write(w, r, apperror.Unauthorized(code, "탈퇴한 계정입니다."))
+		return false
+	}
+	message := "이용이 정지된 계정입니다."
+	if code == model.AccountBannedCode {
+		message = "이용이 영구 제한된 계정입니다."
+	}
+	appErr := apperror.Forbidden(code, message).WithDetail("reason", status.Reason)
+	if code == model.AccountSuspendedCode && status.SuspendedUntil != nil {
+		appErr = appErr.WithDetail("suspended_until", status.SuspendedUntil)
+	}
+	apperror.Write(w, r, appErr)
+	return false
+}
+
+// checkConsent 미동의 필수 약관이 있으면 403 응답 후 false 반환
+func (m *AuthMiddleware) checkConsent(w http.ResponseWriter, r *http.Request, userID int) bool {
+	pending, err := m.consentChecker.PendingMandatoryDocuments(r.Context(), userID)
+	if err != nil {
+		apperror.Write(w, r, errors.Wrapf(err, "[AuthMiddleware] Failed to check consents, userID=%d", userID))
+		return false
+	}
+	if len(pending) == 0 {
+		return true
+	}
+
+	log.Ctx(r.Context()).Info().Int("pending", len(pending)).Msg("[AuthMiddleware] Consent required")
+	apperror.Write(w, r, errConsentRequired.WithDetail("pending_documents", pending))
+	return false
+}
+
+// OptionalAuth 인증 선택 모드: 유효한 자격 증명이 있으면 Principal 을 붙이고, 없거나 유효하지 않으면 익명으로 통과
+// 정지/차단/탈퇴한 계정도 익명으로 취급
+func (m *AuthMiddleware) OptionalAuth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p, err := m.authenticate(w, r)
+		if err != nil {
+			log.Ctx(r.Context()).Debug().Err(err).Msg("[AuthMiddleware] OptionalAuth: continuing anonymously")
+			next.ServeHTTP(w, r)
 			return
 		}
-		userID := claims.GetUserID()
-		log.Info().Msgf("[AuthMiddleware] Extracted userID from token claims: %d", userID)
-		if userID == 0 {
-			log.Error().Msgf("[AuthMiddleware] No userID found in token claims, raw claims=%+v", token.Claims)
-			http.Error(w, "Unauthorized (no user in token)", http.StatusUnauthorized)
+		status, err := m.statusChecker.AccountStatus(r.Context(), p.UserID)
+		if err != nil || status.BlockCode(time.Now()) != "" {
+			next.ServeHTTP(w, r)
 			return
 		}
+		p.Role = status.Role
+		withUser(r.Context(), p)
+		m.activityTracker.Touch(p.UserID)
+		next.ServeHTTP(w, r.WithContext(principal.NewContext(r.Context(), p)))
+	})
+}
+
+// withUser 이후 요청 로그(접근 로그 포함)에 user_id 가 붙도록 요청 로거에 추가
+func withUser(ctx context.Context, p *principal.Principal) {
+	logger.UpdateContext(ctx, func(c zerolog.Context) zerolog.Context {
+		return c.Int("user_id", p.UserID)
+	})
+}
 
-		log.Info().Msgf("[AuthMiddleware] Token verified successfully, userID: %d", userID)
+// authFailure 인증 실패 사유 (401 응답 메시지에 사용)
+type authFailure struct {
+	reason string
+	cause  error
+}
 
-		ctx := context.WithValue(r.Context(), "userID", userID)
-		r = r.WithContext(ctx)
+func (e *authFailure) Error() string {
+	if e.cause == nil {
+		return e.reason
+	}
+	return e.reason + ": " + e.cause.Error()
+}
 
-		next.ServeHTTP(w, r)
-	})
+// authenticate 쿠키에서 토큰을 읽어 Principal 을 구성
+// access_token 이 없거나 검증에 실패하면 refresh_token 으로 재발급을 시도하고, 새 access_token 쿠키를 설정
+func (m *AuthMiddleware) authenticate(w http.ResponseWriter, r *http.Request) (*principal.Principal, error) {
+	// 1) access_token 쿠키 검증 (같은 키로 서명한 refresh/restore 토큰은 scope 가 달라 거부)
+	if accessToken, ok := m.cookies.Value(r, cookie.AccessToken); ok {
+		token, parseErr := m.jwtManager.VerifyToken(accessToken)
+		if parseErr == nil {
+			p, claimsErr := principalFromToken(token, principal.AuthMethodAccessToken)
+			if claimsErr == nil {
+				return p, nil
+			}
+			parseErr = claimsErr
+		}
+		log.Ctx(r.Context()).Debug().Err(parseErr).Msg("[AuthMiddleware] Access token verification failed, attempting reissue using refresh token")
+	}
+
+	// 2) refresh_token 으로 재발급
+	refreshToken, ok := m.cookies.Value(r, cookie.RefreshToken)
+	if !ok {
+		return nil, &authFailure{reason: "no tokens"}
+	}
+	// 재발급은 DB 조회가 필요하고 refresh_token 대입 시도에 쓰일 수 있으므로 IP 별 빈도 제한 (초과 시 429)
+	if limited := m.rateLimit.check(r, PolicyTokenReissue); limited != nil {
+		return nil, limited
+	}
+	newToken, reissueErr := m.tryReissueAccessToken(r.Context(), w, refreshToken)
+	if reissueErr != nil {
+		return nil, &authFailure{reason: "refresh token invalid", cause: reissueErr}
+	}
+	return principalFromToken(newToken, principal.AuthMethodRefreshToken)
+}
+
+// principalFromToken 검증된 토큰의 클레임으로 Principal 구성
+// access token scope(service.AccessTokenScope)가 없는 토큰은 거부 (refresh token 을 access_token 쿠키에 넣어
+// refresh_tokens 조회, 세션 폐기, 재발급 빈도 제한을 우회하는 것 방지)
+func principalFromToken(token *jwt.Token, method principal.AuthMethod) (*principal.Principal, error) {
+	claims, ok := token.Claims.(service.MapClaimsWithSubID)
+	if !ok {
+		return nil, &authFailure{reason: "invalid token claims", cause: errors.Errorf("unexpected claims type %T", token.Claims)}
+	}
+	userID := claims.GetUserID()
+	if userID == 0 {
+		return nil, &authFailure{reason: "no user in token"}
+	}
+	p := &principal.Principal{
+		UserID:     userID,
+		Email:      claims.GetEmail(),
+		Role:       claims.GetRole(),
+		AuthMethod: method,
+		SessionID:  claims.GetSessionID(),
+		Scopes:     claims.GetScopes(),
+	}
+	if !p.HasScope(service.AccessTokenScope) {
+		return nil, &authFailure{reason: "token scope not allowed", cause: errors.Errorf("scopes=%v", p.Scopes)}
+	}
+	return p, nil
 }
 
 // tryReissueAccessToken refresh token 을 통해 새 Access Token을 재발급하고, 재발급된 토큰을 반환
-func (m *AuthMiddleware) tryReissueAccessToken(w http.ResponseWriter, refreshToken string) (*jwt.Token, error) {
-	log.Info().Msgf("[tryReissueAccessToken] Attempting to reissue access token using refresh token: %s", refreshToken)
+// (토큰 값은 로그에 남기지 않음)
+func (m *AuthMiddleware) tryReissueAccessToken(ctx context.Context, w http.ResponseWriter, refreshToken string) (*jwt.Token, error) {
 	// 1) refresh_tokens 테이블 조회
-	rt, err := m.refreshTokenRepo.FindByToken(context.Background(), refreshToken)
+	rt, err := m.refreshTokenRepo.FindByToken(ctx, refreshToken)
 	if err != nil {
-		log.Error().Err(err).Msgf("[tryReissueAccessToken] Refresh token not found in DB, token=%s", refreshToken)
 		return nil, errors.Wrap(err, "[tryReissueAccessToken] refresh token not found in DB")
 	}
-	log.Info().Msgf("[tryReissueAccessToken] Retrieved refresh token from DB: userID=%d, expired_at=%s", rt.UserID, rt.ExpiredAt.Format(time.RFC3339))
 	if time.Now().After(rt.ExpiredAt) {
-		log.Error().Msg("[tryReissueAccessToken] Refresh token expired")
-		return nil, errors.New("[tryReissueAccessToken] refresh token expired")
+		return nil, errors.Errorf("[tryReissueAccessToken] refresh token expired at %s, userID=%d", rt.ExpiredAt.Format(time.RFC3339), rt.UserID)
 	}
 
 	// 2) userRepo를 통해 rt.UserID로 유저 정보 조회
-	user, err := m.userRepo.FindByID(context.Background(), rt.UserID)
+	user, err := m.userRepo.FindByID(ctx, rt.UserID)
 	if err != nil {
-		log.Error().Err(err).Msgf("[tryReissueAccessToken] Cannot find user by ID=%d", rt.UserID)
 		return nil, errors.Wrapf(err, "[tryReissueAccessToken] cannot find user by ID=%d", rt.UserID)
 	}
-	log.Info().Msgf("[tryReissueAccessToken] Found user: %+v", user)
 
 	// 3) 새 Access Token 생성
-	newAccessToken, err := m.jwtManager.GenerateAccessToken(user)
+	newAccessToken, err := m.jwtManager.GenerateAccessToken(user, rt.ID)
 	if err != nil {
-		log.Error().Err(err).Msg("[tryReissueAccessToken] Failed to generate new access token")
 		return nil, errors.Wrap(err, "[tryReissueAccessToken] failed to generate new access token")
 	}
-	log.Info().Msgf("[tryReissueAccessToken] Generated new access token: %s", newAccessToken)
-
-	// 4) 개발 환경에 따른 Secure 쿠키 설정: 로컬 개발 환경(HTTP)에서는 Secure false
-	secureFlag := true
-	if os.Getenv(flags.EnvVarEnvironment) == "local" {
-		secureFlag = false
-	}
-	// access_token 쿠키 재설정
-	http.SetCookie(w, &http.Cookie{
-		Name:     "access_token",
-		Value:    newAccessToken,
-		Path:     "/",
-		HttpOnly: true,
-		Secure:   secureFlag,
-		SameSite: http.SameSiteLaxMode,
-		Expires:  time.Now().Add(m.jwtManager.AccessTokenTTL),
-		MaxAge:   int(m.jwtManager.AccessTokenTTL.Seconds()),
-	})
-	log.Info().Msgf("[tryReissueAccessToken] Set new access token in cookie with secure=%t", secureFlag)
+
+	// 4) access_token 쿠키 재설정 (로그인과 같은 cookie 설정 사용)
+	m.cookies.Set(w, cookie.AccessToken, newAccessToken, m.jwtManager.AccessTokenTTL)
 
 	// 5) 새 토큰을 파싱하여 반환
 	parsedToken, err := m.jwtManager.VerifyToken(newAccessToken)
 	if err != nil {
-		log.Error().Err(err).Msg("[tryReissueAccessToken] Failed to parse new access token")
 		return nil, errors.Wrap(err, "[tryReissueAccessToken] failed to parse new access token")
 	}
-	log.Info().Msgf("[tryReissueAccessToken] Successfully parsed new token, raw=%s", parsedToken.Raw)
+	log.Ctx(ctx).Info().Int("user_id", rt.UserID).Msg("[tryReissueAccessToken] access token reissued")
 	return parsedToken, nil
 }
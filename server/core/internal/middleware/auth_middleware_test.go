@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"server/internal/config"
+	"server/internal/cookie"
+	"server/internal/model"
+	"server/internal/principal"
+	"server/internal/service"
+	"testing"
+	"time"
+)
+
+type fakeStatusChecker struct{}
+
+func (fakeStatusChecker) AccountStatus(ctx context.Context, userID int) (*model.AccountStatus, error) {
+	return &model.AccountStatus{UserID: userID, Role: model.RoleUser, Status: model.UserStatusActive}, nil
+}
+
+type nopActivityTracker struct{}
+
+func (nopActivityTracker) Touch(userID int) {}
+
+// TestAuthenticateRequiresAccessTokenScope 같은 키로 서명한 refresh/restore 토큰을 access_token 쿠키에 넣어도 인증되지 않음
+func TestAuthenticateRequiresAccessTokenScope(t *testing.T) {
+	cfg := &config.AppConfig{}
+	cfg.Endpoints.BackendBaseURL = "http://localhost:8080"
+	cookies, err := cookie.NewPolicy(cfg)
+	if err != nil {
+		t.Fatalf("NewPolicy: %v", err)
+	}
+	jwtManager := &service.JWTManager{
+		SecretKey:       "test-secret",
+		AccessTokenTTL:  time.Minute,
+		RefreshTokenTTL: time.Hour,
+		RestoreTokenTTL: time.Minute,
+	}
+	m := NewAuthMiddleware(jwtManager, nil, nil, nil, fakeStatusChecker{}, nopActivityTracker{}, nil, cookies)
+	user := &model.User{ID: 7, Email: "u@example.com", Role: model.RoleUser}
+
+	tests := []struct {
+		name     string
+		generate func() (string, error)
+		want     int
+	}{
+		{"access token", func() (string, error) { return jwtManager.GenerateAccessToken(user, 3) }, http.StatusOK},
+		{"refresh token", func() (string, error) { return jwtManager.GenerateRefreshToken(user) }, http.StatusUnauthorized},
+		{"restore token", func() (string, error) { return jwtManager.GenerateRestoreToken(user) }, http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := tt.generate()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got *principal.Principal
+			h := m.HandleSkipConsent(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got, _ = principal.FromContext(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/users/me", nil)
+			req.AddCookie(&http.Cookie{Name: cookies.Name(cookie.AccessToken), Value: token})
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want {
+				t.Fatalf("status = %d, want %d (body %s)", rec.Code, tt.want, rec.Body)
+			}
+			if tt.want == http.StatusOK && (got == nil || got.UserID != 7 || got.SessionID != 3 || !got.HasScope(service.AccessTokenScope)) {
+				t.Errorf("principal = %+v", got)
+			}
+		})
+	}
+}
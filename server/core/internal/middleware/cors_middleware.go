@@ -2,42 +2,196 @@ package middleware
 
 import (
 	"net/http"
+	"net/url"
 	"server/internal/config"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
 )
 
-func NewCORSMiddleware(cfg *config.AppConfig) func(http.Handler) http.Handler {
+// corsPolicy cors 설정을 요청마다 다시 해석하지 않도록 미리 정리한 값
+type corsPolicy struct {
+	origins     map[string]bool // 정확히 일치해야 하는 Origin (소문자)
+	patterns    []originPattern // https://*.dev.step-journey.com 형식
+	anyOrigin   bool            // "*"
+	methods     map[string]bool
+	headers     map[string]bool // 소문자
+	allowMethod string
+	allowHeader string
+	expose      string
+	maxAge      string
+	credentials bool
+}
+
+// originPattern 서브도메인 한 단계를 와일드카드로 허용 (pr-123.dev.step-journey.com 은 허용, a.b.dev.step-journey.com 은 불가)
+type originPattern struct {
+	scheme string
+	suffix string // ".dev.step-journey.com"
+	port   string
+}
+
+// NewCORSMiddleware cors 설정 기반 CORS 처리
+// 허용하지 않은 Origin 에는 CORS 헤더를 하나도 보내지 않고, Origin 마다 응답이 달라지므로 항상 Vary: Origin
+// endpoints.frontend_base_url, endpoints.backend_base_url 은 별도 설정 없이 허용
+func NewCORSMiddleware(cfg *config.AppConfig) (func(http.Handler) http.Handler, error) {
+	policy, err := newCORSPolicy(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "[NewCORSMiddleware] invalid cors config")
+	}
+
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			h := w.Header()
+			h.Add("Vary", "Origin")
+
 			origin := r.Header.Get("Origin")
-			// 허용할 Origin 리스트 (Backend, Frontend)
-			allowed := []string{
-				cfg.Endpoints.BackendBaseURL,
-				cfg.Endpoints.FrontendBaseURL,
+			preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+			if origin == "" {
+				next.ServeHTTP(w, r)
+				return
 			}
 
-			if origin != "" && contains(allowed, origin) {
-				w.Header().Set("Access-Control-Allow-Origin", origin)
+			allowed := policy.allowOrigin(origin)
+			if !preflight {
+				if allowed {
+					policy.setOriginHeaders(h, origin)
+					if policy.expose != "" {
+						h.Set("Access-Control-Expose-Headers", policy.expose)
+					}
+				}
+				next.ServeHTTP(w, r)
+				return
 			}
 
-			w.Header().Set("Access-Control-Allow-Credentials", "true")
-			w.Header().Set("Access-Control-Allow-Methods", "GET,POST,OPTIONS,PUT,DELETE")
-			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
-
-			if r.Method == http.MethodOptions {
-				w.WriteHeader(http.StatusOK)
+			// preflight 는 라우터까지 보내지 않고 여기서 응답 (허용하지 않으면 헤더 없이 204 → 브라우저가 차단)
+			h.Add("Vary", "Access-Control-Request-Method")
+			h.Add("Vary", "Access-Control-Request-Headers")
+			if !allowed {
+				log.Ctx(r.Context()).Debug().Str("origin", origin).Msg("[CORSMiddleware] preflight from disallowed origin")
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			method := r.Header.Get("Access-Control-Request-Method")
+			reqHeaders := r.Header.Values("Access-Control-Request-Headers")
+			if !policy.methods[strings.ToUpper(method)] || !policy.allowHeaders(reqHeaders) {
+				log.Ctx(r.Context()).Debug().
+					Str("origin", origin).
+					Str("method", method).
+					Strs("headers", reqHeaders).
+					Msg("[CORSMiddleware] preflight requests disallowed method or headers")
+				w.WriteHeader(http.StatusNoContent)
 				return
 			}
 
-			next.ServeHTTP(w, r)
+			policy.setOriginHeaders(h, origin)
+			h.Set("Access-Control-Allow-Methods", policy.allowMethod)
+			if policy.allowHeader != "" {
+				h.Set("Access-Control-Allow-Headers", policy.allowHeader)
+			}
+			if policy.maxAge != "" {
+				h.Set("Access-Control-Max-Age", policy.maxAge)
+			}
+			w.WriteHeader(http.StatusNoContent)
 		})
+	}, nil
+}
+
+func newCORSPolicy(cfg *config.AppConfig) (*corsPolicy, error) {
+	c := cfg.CORS
+	p := &corsPolicy{
+		origins:     make(map[string]bool),
+		methods:     make(map[string]bool),
+		headers:     make(map[string]bool),
+		allowMethod: strings.ToUpper(strings.Join(c.AllowedMethods, ", ")),
+		allowHeader: strings.Join(c.AllowedHeaders, ", "),
+		expose:      strings.Join(c.ExposedHeaders, ", "),
+		credentials: c.AllowCredentials,
+	}
+	if c.MaxAgeSeconds > 0 {
+		p.maxAge = strconv.Itoa(c.MaxAgeSeconds)
+	}
+
+	origins := append([]string{cfg.Endpoints.FrontendBaseURL, cfg.Endpoints.BackendBaseURL}, c.AllowedOrigins...)
+	for _, o := range origins {
+		o = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(o), "/"))
+		switch {
+		case o == "":
+		case o == "*":
+			p.anyOrigin = true
+		case strings.Contains(o, "*"):
+			pattern, err := parseOriginPattern(o)
+			if err != nil {
+				return nil, err
+			}
+			p.patterns = append(p.patterns, pattern)
+		default:
+			p.origins[o] = true
+		}
+	}
+	// 임의의 Origin 에 쿠키를 보내도록 허용하면 모든 사이트가 로그인 사용자 권한으로 API 호출 가능
+	if p.anyOrigin && p.credentials {
+		return nil, errors.New(`allowed_origins "*" cannot be used with allow_credentials`)
+	}
+
+	for _, m := range c.AllowedMethods {
+		p.methods[strings.ToUpper(m)] = true
 	}
+	for _, h := range c.AllowedHeaders {
+		p.headers[strings.ToLower(h)] = true
+	}
+	return p, nil
 }
 
-func contains(slice []string, val string) bool {
-	for _, item := range slice {
-		if item == val {
+// parseOriginPattern "https://*.dev.step-journey.com[:port]"
+func parseOriginPattern(o string) (originPattern, error) {
+	scheme, host, ok := strings.Cut(o, "://")
+	if !ok || !strings.HasPrefix(host, "*.") || strings.Count(host, "*") != 1 {
+		return originPattern{}, errors.Errorf("invalid origin pattern %q (expected scheme://*.domain)", o)
+	}
+	host, port, _ := strings.Cut(host, ":")
+	return originPattern{scheme: scheme, suffix: host[1:], port: port}, nil
+}
+
+func (p *corsPolicy) allowOrigin(origin string) bool {
+	origin = strings.ToLower(origin)
+	if p.anyOrigin || p.origins[origin] {
+		return true
+	}
+	if len(p.patterns) == 0 {
+		return false
+	}
+	u, err := url.Parse(origin)
+	if err != nil || u.Host == "" {
+		return false
+	}
+	for _, pat := range p.patterns {
+		label, ok := strings.CutSuffix(u.Hostname(), pat.suffix)
+		if ok && u.Scheme == pat.scheme && u.Port() == pat.port && label != "" && !strings.Contains(label, ".") {
 			return true
 		}
 	}
 	return false
 }
+
+// allowHeaders preflight 가 요청한 헤더가 모두 허용 목록에 있는지 (값은 쉼표로 구분된 목록)
+func (p *corsPolicy) allowHeaders(values []string) bool {
+	for _, v := range values {
+		for _, name := range strings.Split(v, ",") {
+			name = strings.ToLower(strings.TrimSpace(name))
+			if name != "" && !p.headers[name] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// setOriginHeaders 요청 Origin 을 그대로 돌려줌 ("*" 로 응답하면 쿠키를 보낼 수 없음)
+func (p *corsPolicy) setOriginHeaders(h http.Header, origin string) {
+	h.Set("Access-Control-Allow-Origin", origin)
+	if p.credentials {
+		h.Set("Access-Control-Allow-Credentials", "true")
+	}
+}
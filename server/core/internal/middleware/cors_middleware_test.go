@@ -0,0 +1,176 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"server/internal/config"
+	"slices"
+	"testing"
+)
+
+func testCORSConfig() *config.AppConfig {
+	cfg := &config.AppConfig{}
+	cfg.Endpoints.FrontendBaseURL = "https://step-journey.com"
+	cfg.Endpoints.BackendBaseURL = "https://api.step-journey.com"
+	cfg.CORS.AllowedOrigins = []string{"https://*.dev.step-journey.com", "http://localhost:3000"}
+	cfg.CORS.AllowedMethods = []string{"GET", "POST", "PATCH", "DELETE", "OPTIONS"}
+	cfg.CORS.AllowedHeaders = []string{"Content-Type", "X-Request-ID", "Idempotency-Key"}
+	cfg.CORS.ExposedHeaders = []string{"X-Request-ID", "Retry-After"}
+	cfg.CORS.MaxAgeSeconds = 600
+	cfg.CORS.AllowCredentials = true
+	return cfg
+}
+
+func TestCORSPolicyAllowOrigin(t *testing.T) {
+	policy, err := newCORSPolicy(testCORSConfig())
+	if err != nil {
+		t.Fatalf("newCORSPolicy: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		origin string
+		want   bool
+	}{
+		{"frontend endpoint", "https://step-journey.com", true},
+		{"backend endpoint", "https://api.step-journey.com", true},
+		{"exact origin", "http://localhost:3000", true},
+		{"exact origin is case insensitive", "HTTP://LOCALHOST:3000", true},
+		{"one label wildcard", "https://pr-123.dev.step-journey.com", true},
+		{"two labels under wildcard", "https://a.b.dev.step-journey.com", false},
+		{"wildcard base domain itself", "https://dev.step-journey.com", false},
+		{"wildcard scheme mismatch", "http://pr-123.dev.step-journey.com", false},
+		{"wildcard port mismatch", "https://pr-123.dev.step-journey.com:8443", false},
+		{"exact origin port mismatch", "http://localhost:3001", false},
+		{"exact origin scheme mismatch", "https://localhost:3000", false},
+		{"suffix lookalike", "https://evil-dev.step-journey.com.attacker.io", false},
+		{"unrelated origin", "https://attacker.io", false},
+		{"null origin", "null", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.allowOrigin(tt.origin); got != tt.want {
+				t.Errorf("allowOrigin(%q) = %v, want %v", tt.origin, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewCORSPolicyRejectsInvalidConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		origins []string
+		creds   bool
+		wantErr bool
+	}{
+		{"any origin with credentials", []string{"*"}, true, true},
+		{"any origin without credentials", []string{"*"}, false, false},
+		{"wildcard not at first label", []string{"https://dev.*.step-journey.com"}, true, true},
+		{"wildcard without scheme", []string{"*.dev.step-journey.com"}, true, true},
+		{"two wildcards", []string{"https://*.*.step-journey.com"}, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testCORSConfig()
+			cfg.CORS.AllowedOrigins = tt.origins
+			cfg.CORS.AllowCredentials = tt.creds
+			_, err := newCORSPolicy(cfg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("newCORSPolicy(%v, credentials=%v) error = %v, wantErr %v", tt.origins, tt.creds, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCORSMiddleware(t *testing.T) {
+	mw, err := NewCORSMiddleware(testCORSConfig())
+	if err != nil {
+		t.Fatalf("NewCORSMiddleware: %v", err)
+	}
+	handler := mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+
+	const allowed = "https://pr-1.dev.step-journey.com"
+	tests := []struct {
+		name          string
+		method        string
+		origin        string
+		reqMethod     string // Access-Control-Request-Method (preflight)
+		reqHeaders    string // Access-Control-Request-Headers
+		wantStatus    int
+		wantAllow     bool
+		wantPreflight bool // Allow-Methods / Allow-Headers / Max-Age
+	}{
+		{name: "no origin", method: http.MethodGet, wantStatus: http.StatusTeapot},
+		{name: "allowed origin", method: http.MethodGet, origin: allowed, wantStatus: http.StatusTeapot, wantAllow: true},
+		{name: "disallowed origin reaches handler without cors headers", method: http.MethodPost, origin: "https://attacker.io", wantStatus: http.StatusTeapot},
+		{
+			name: "preflight allowed", method: http.MethodOptions, origin: allowed,
+			reqMethod: "PATCH", reqHeaders: "content-type, idempotency-key",
+			wantStatus: http.StatusNoContent, wantAllow: true, wantPreflight: true,
+		},
+		{
+			name: "preflight from disallowed origin", method: http.MethodOptions, origin: "https://attacker.io",
+			reqMethod: "POST", wantStatus: http.StatusNoContent,
+		},
+		{
+			name: "preflight with disallowed method", method: http.MethodOptions, origin: allowed,
+			reqMethod: "PUT", wantStatus: http.StatusNoContent,
+		},
+		{
+			name: "preflight with disallowed header", method: http.MethodOptions, origin: allowed,
+			reqMethod: "POST", reqHeaders: "Content-Type, X-Evil", wantStatus: http.StatusNoContent,
+		},
+		{name: "options without request method is not preflight", method: http.MethodOptions, origin: allowed, wantStatus: http.StatusTeapot, wantAllow: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/api/v1/users/me", nil)
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			if tt.reqMethod != "" {
+				req.Header.Set("Access-Control-Request-Method", tt.reqMethod)
+			}
+			if tt.reqHeaders != "" {
+				req.Header.Set("Access-Control-Request-Headers", tt.reqHeaders)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			h := rec.Header()
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if !slices.Contains(h.Values("Vary"), "Origin") {
+				t.Errorf("Vary = %v, want Origin on every response", h.Values("Vary"))
+			}
+
+			acao, acac := h.Get("Access-Control-Allow-Origin"), h.Get("Access-Control-Allow-Credentials")
+			if tt.wantAllow {
+				if acao != tt.origin || acac != "true" {
+					t.Errorf("ACAO = %q, ACAC = %q, want %q and true", acao, acac, tt.origin)
+				}
+			} else if acao != "" || acac != "" {
+				t.Errorf("ACAO = %q, ACAC = %q, want none", acao, acac)
+			}
+
+			gotPreflight := h.Get("Access-Control-Allow-Methods") != "" ||
+				h.Get("Access-Control-Allow-Headers") != "" ||
+				h.Get("Access-Control-Max-Age") != ""
+			if gotPreflight != tt.wantPreflight {
+				t.Errorf("preflight headers present = %v, want %v (%v)", gotPreflight, tt.wantPreflight, h)
+			}
+			if tt.wantPreflight && h.Get("Access-Control-Max-Age") != "600" {
+				t.Errorf("Access-Control-Max-Age = %q, want 600", h.Get("Access-Control-Max-Age"))
+			}
+			if tt.reqMethod != "" && !slices.Contains(h.Values("Vary"), "Access-Control-Request-Method") {
+				t.Errorf("preflight Vary = %v, want Access-Control-Request-Method", h.Values("Vary"))
+			}
+			if tt.wantAllow && tt.reqMethod == "" && h.Get("Access-Control-Expose-Headers") != "X-Request-ID, Retry-After" {
+				t.Errorf("Access-Control-Expose-Headers = %q", h.Get("Access-Control-Expose-Headers"))
+			}
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"server/internal/apperror"
+	"server/internal/clientip"
+	"server/internal/config"
+	"server/internal/idempotency"
+	"server/internal/principal"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// IdempotencyKeyHeader 클라이언트가 재시도마다 같은 값으로 보내는 헤더 (UUID 권장)
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader 저장한 응답을 재전송한 경우 "true"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+)
+
+var (
+	errIdempotencyKeyInvalid = apperror.BadRequest("IDEMPOTENCY_KEY_INVALID", "Idempotency-Key 는 255자 이하의 출력 가능한 ASCII 문자여야 합니다.")
+	errIdempotencyKeyInUse   = apperror.Conflict("IDEMPOTENCY_KEY_IN_USE", "같은 Idempotency-Key 의 요청을 처리 중입니다. 잠시 후 다시 시도해주세요.")
+	errIdempotencyKeyReused  = apperror.New(apperror.TypeValidation, "IDEMPOTENCY_KEY_REUSED", "다른 요청에 사용한 Idempotency-Key 입니다.")
+	errIdempotencyBodyLarge  = apperror.New(apperror.TypeTooLarge, "BODY_TOO_LARGE", "요청 본문이 너무 큽니다.")
+)
+
+// IdempotencyMiddleware Idempotency-Key 헤더가 있는 변경 요청(POST/PUT/PATCH/DELETE)의 응답을 저장해 재시도에 재전송
+// key 는 유저별(비로그인은 IP별)로 구분하므로 인증 미들웨어 안쪽에 걸어야 함
+// 5xx 응답이나 panic 은 저장하지 않고 key 를 풀어 같은 key 로 다시 처리할 수 있게 함
+type IdempotencyMiddleware struct {
+	store          idempotency.Store
+	ttl            time.Duration
+	lockTimeout    time.Duration
+	maxBodyBytes   int64
+	trustedProxies int
+}
+
+func NewIdempotencyMiddleware(store idempotency.Store, cfg *config.AppConfig) *IdempotencyMiddleware {
+	return &IdempotencyMiddleware{
+		store:          store,
+		ttl:            cfg.IdempotencyTTL(),
+		lockTimeout:    cfg.IdempotencyLockTimeout(),
+		maxBodyBytes:   cfg.Idempotency.MaxBodyBytes,
+		trustedProxies: cfg.RateLimit.TrustedProxies,
+	}
+}
+
+// Handle m 이 nil 이면(openapi 명령 등) 그대로 통과
+func (m *IdempotencyMiddleware) Handle(next http.Handler) http.Handler {
+	if m == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(IdempotencyKeyHeader)
+		if key == "" || !mutatingMethod(r.Method) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if !validIdempotencyKey(key) {
+			apperror.Write(w, r, errIdempotencyKeyInvalid)
+			return
+		}
+
+		// fingerprint 계산을 위해 본문을 미리 읽고 핸들러에는 같은 내용으로 다시 전달
+		body, err := io.ReadAll(io.LimitReader(r.Body, m.maxBodyBytes+1))
+		if err != nil {
+			apperror.Write(w, r, apperror.BadRequest("INVALID_BODY", "요청 본문을 읽을 수 없습니다.").Wrap(err))
+			return
+		}
+		if int64(len(body)) > m.maxBodyBytes {
+			apperror.Write(w, r, errIdempotencyBodyLarge.WithDetail("limit", m.maxBodyBytes))
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		storeKey := m.scope(r) + ":" + key
+		fingerprint := idempotency.Fingerprint(r.Method, r.URL.RequestURI(), body)
+		attemptID := idempotency.NewAttemptID()
+		rec, acquired, err := m.store.Begin(r.Context(), storeKey, attemptID, fingerprint, m.lockTimeout, m.ttl)
+		if err != nil {
+			// 중복 처리를 막는 것이 목적이므로 저장소 오류면 처리하지 않음 (같은 key 로 재시도 가능)
+			apperror.Write(w, r, apperror.Internal(err))
+			return
+		}
+		if !acquired {
+			m.respondExisting(w, r, rec, fingerprint)
+			return
+		}
+		m.serveAndStore(w, r, next, storeKey, attemptID)
+	})
+}
+
+// serveAndStore 선점한 key 로 요청을 처리하고 응답 저장
+func (m *IdempotencyMiddleware) serveAndStore(w http.ResponseWriter, r *http.Request, next http.Handler, storeKey, attemptID string) {
+	// 응답 후 클라이언트 연결이 끊겨도 저장/해제는 마쳐야 함
+	ctx := context.WithoutCancel(r.Context())
+	completed := false
+	defer func() {
+		if completed {
+			return
+		}
+		// 5xx, 저장 실패, panic (panic 은 바깥의 RecoverMiddleware 까지 그대로 전파)
+		if err := m.store.Release(ctx, storeKey, attemptID); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("[IdempotencyMiddleware] release key failed")
+		}
+	}()
+
+	before := w.Header().Clone()
+	rec := &bufferingRecorder{ResponseWriter: w, status: http.StatusOK}
+	next.ServeHTTP(rec, r)
+	if rec.status >= http.StatusInternalServerError {
+		return
+	}
+
+	resp := idempotency.Response{
+		Status: rec.status,
+		Header: handlerHeaders(before, w.Header()),
+		Body:   rec.body.Bytes(),
+	}
+	if err := m.store.Complete(ctx, storeKey, attemptID, resp); err != nil {
+		if errors.Is(err, idempotency.ErrNotOwner) {
+			// lock_timeout 보다 오래 걸려 재시도가 이미 넘겨받음 (그 요청의 결과를 남김)
+			log.Ctx(ctx).Warn().Dur("lock_timeout", m.lockTimeout).Msg("[IdempotencyMiddleware] key taken over while processing, response not stored")
+			completed = true
+			return
+		}
+		log.Ctx(ctx).Error().Err(err).Msg("[IdempotencyMiddleware] store response failed")
+		return
+	}
+	completed = true
+}
+
+// respondExisting 이미 선점된 key: 다른 요청이면 422, 처리 중이면 409, 처리가 끝났으면 저장한 응답 재전송
+func (m *IdempotencyMiddleware) respondExisting(w http.ResponseWriter, r *http.Request, rec idempotency.Record, fingerprint string) {
+	switch {
+	case rec.Fingerprint != fingerprint:
+		log.Ctx(r.Context()).Warn().Msg("[IdempotencyMiddleware] idempotency key reused with a different request")
+		apperror.Write(w, r, errIdempotencyKeyReused)
+	case rec.Response == nil:
+		w.Header().Set("Retry-After", "1")
+		apperror.Write(w, r, errIdempotencyKeyInUse)
+	default:
+		log.Ctx(r.Context()).Info().Int("status", rec.Response.Status).Msg("[IdempotencyMiddleware] replaying stored response")
+		h := w.Header()
+		for k, v := range rec.Response.Header {
+			h[k] = v
+		}
+		h.Set(IdempotentReplayedHeader, "true")
+		w.WriteHeader(rec.Response.Status)
+		w.Write(rec.Response.Body)
+	}
+}
+
+// scope key 를 유저별로 구분 (비로그인 요청은 IP)
+func (m *IdempotencyMiddleware) scope(r *http.Request) string {
+	if userID, ok := principal.UserID(r.Context()); ok {
+		return "user:" + strconv.Itoa(userID)
+	}
+	return "ip:" + clientip.Behind(r, m.trustedProxies)
+}
+
+func mutatingMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func validIdempotencyKey(key string) bool {
+	if len(key) > maxIdempotencyKeyLength {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] < 0x21 || key[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// handlerHeaders 핸들러가 추가/변경한 응답 헤더 (요청 ID, CORS, RateLimit 등 바깥 미들웨어 헤더와 쿠키는 재전송하지 않음)
+func handlerHeaders(before, after http.Header) http.Header {
+	h := make(http.Header)
+	for k, v := range after {
+		if k == "Set-Cookie" || slices.Equal(before[k], v) {
+			continue
+		}
+		h[k] = v
+	}
+	return h
+}
+
+// bufferingRecorder 클라이언트에 응답하면서 상태 코드와 본문을 저장용으로 복사
+type bufferingRecorder struct {
+	http.ResponseWriter
+	status      int
+	body        bytes.Buffer
+	wroteHeader bool
+}
+
+func (rw *bufferingRecorder) WriteHeader(status int) {
+	if !rw.wroteHeader {
+		rw.status = status
+		rw.wroteHeader = true
+	}
+	rw.ResponseWriter.WriteHeader(status)
+}
+
+func (rw *bufferingRecorder) Write(b []byte) (int, error) {
+	rw.wroteHeader = true
+	rw.body.Write(b)
+	return rw.ResponseWriter.Write(b)
+}
+
+// Unwrap http.ResponseController 가 원래 ResponseWriter 의 Flush 등을 사용할 수 있게
+func (rw *bufferingRecorder) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
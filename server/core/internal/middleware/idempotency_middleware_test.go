@@ -0,0 +1,293 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"server/internal/config"
+	"server/internal/idempotency"
+	"server/internal/principal"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memoryIdempotencyStore PostgresStore 와 같은 규칙의 테스트용 저장소 (잠금 만료는 expire 로 흉내)
+type memoryIdempotencyStore struct {
+	mu   sync.Mutex
+	rows map[string]*memoryIdempotencyRow
+}
+
+type memoryIdempotencyRow struct {
+	attemptID string
+	rec       idempotency.Record
+	lockGone  bool
+}
+
+func newMemoryIdempotencyStore() *memoryIdempotencyStore {
+	return &memoryIdempotencyStore{rows: make(map[string]*memoryIdempotencyRow)}
+}
+
+func (s *memoryIdempotencyStore) Begin(_ context.Context, key, attemptID, fingerprint string, _, _ time.Duration) (idempotency.Record, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	row, ok := s.rows[key]
+	if ok && !(row.rec.Response == nil && row.lockGone && row.rec.Fingerprint == fingerprint) {
+		return row.rec, false, nil
+	}
+	s.rows[key] = &memoryIdempotencyRow{attemptID: attemptID, rec: idempotency.Record{Fingerprint: fingerprint}}
+	return idempotency.Record{}, true, nil
+}
+
+func (s *memoryIdempotencyStore) Complete(_ context.Context, key, attemptID string, resp idempotency.Response) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	row, ok := s.rows[key]
+	if !ok || row.attemptID != attemptID || row.rec.Response != nil {
+		return idempotency.ErrNotOwner
+	}
+	row.rec.Response = &resp
+	return nil
+}
+
+func (s *memoryIdempotencyStore) Release(_ context.Context, key, attemptID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if row, ok := s.rows[key]; ok && row.attemptID == attemptID && row.rec.Response == nil {
+		delete(s.rows, key)
+	}
+	return nil
+}
+
+func (s *memoryIdempotencyStore) Cleanup(context.Context) error { return nil }
+
+// expire 처리 중인 key 의 잠금이 풀린 상태로 만듦 (lock_timeout 경과)
+func (s *memoryIdempotencyStore) expire(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rows[key].lockGone = true
+}
+
+type idempotencyTest struct {
+	t       *testing.T
+	store   *memoryIdempotencyStore
+	handler http.Handler
+
+	mu    sync.Mutex
+	calls int
+	gates map[int]chan struct{} // "wait:" 요청이 n 번째 호출일 때 기다리는 채널
+}
+
+// gate n 번째 핸들러 호출이 기다리는 채널 (open 으로 진행)
+func (it *idempotencyTest) gate(n int) chan struct{} {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	if it.gates[n] == nil {
+		it.gates[n] = make(chan struct{})
+	}
+	return it.gates[n]
+}
+
+func (it *idempotencyTest) open(n int) {
+	close(it.gate(n))
+}
+
+// newIdempotencyTest 본문이 "fail" 이면 500, "wait:" 로 시작하면 open 될 때까지 대기하는 핸들러
+// ("wait:fail-first" 는 첫 번째 호출만 500)
+func newIdempotencyTest(t *testing.T) *idempotencyTest {
+	cfg := &config.AppConfig{}
+	cfg.Idempotency.MaxBodyBytes = 64
+	cfg.Idempotency.TTLHours = 24
+	cfg.Idempotency.LockTimeoutSeconds = 60
+
+	it := &idempotencyTest{t: t, store: newMemoryIdempotencyStore(), gates: make(map[int]chan struct{})}
+	mw := NewIdempotencyMiddleware(it.store, cfg)
+	inner := mw.Handle(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		it.mu.Lock()
+		it.calls++
+		call := it.calls
+		it.mu.Unlock()
+		if strings.HasPrefix(string(body), "wait:") {
+			<-it.gate(call)
+		}
+		if string(body) == "fail" || (string(body) == "wait:fail-first" && call == 1) {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Location", "/things/1")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"body":"` + string(body) + `"}`))
+	}))
+	// 바깥 미들웨어가 요청마다 다른 헤더를 붙이는 상황 (재전송 대상이 아님)
+	it.handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-ID", r.Header.Get("X-Test-Request"))
+		ctx := principal.NewContext(r.Context(), &principal.Principal{UserID: 7})
+		inner.ServeHTTP(w, r.WithContext(ctx))
+	})
+	return it
+}
+
+func (it *idempotencyTest) do(method, key, body, requestID string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "/things", strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	req.Header.Set("X-Test-Request", requestID)
+	rec := httptest.NewRecorder()
+	it.handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func (it *idempotencyTest) callCount() int {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	return it.calls
+}
+
+func TestIdempotencyMiddlewareReplaysStoredResponse(t *testing.T) {
+	it := newIdempotencyTest(t)
+
+	first := it.do(http.MethodPost, "k1", "a", "req-1")
+	second := it.do(http.MethodPost, "k1", "a", "req-2")
+
+	if it.callCount() != 1 {
+		t.Fatalf("handler calls = %d, want 1", it.callCount())
+	}
+	if second.Code != http.StatusCreated || second.Body.String() != first.Body.String() {
+		t.Errorf("replay = %d %q, want %d %q", second.Code, second.Body.String(), first.Code, first.Body.String())
+	}
+	if got := second.Header().Get(IdempotentReplayedHeader); got != "true" {
+		t.Errorf("%s = %q, want true", IdempotentReplayedHeader, got)
+	}
+	if got := second.Header().Get("Location"); got != "/things/1" {
+		t.Errorf("replayed Location = %q", got)
+	}
+	if got := second.Header().Get("X-Request-ID"); got != "req-2" {
+		t.Errorf("X-Request-ID = %q, want the retry's own request ID", got)
+	}
+	if first.Header().Get(IdempotentReplayedHeader) != "" {
+		t.Error("first response must not be marked as replayed")
+	}
+}
+
+func TestIdempotencyMiddlewareRejects(t *testing.T) {
+	tests := []struct {
+		name       string
+		key        string
+		body       string
+		wantStatus int
+		wantCode   string
+	}{
+		{"key reused with different body", "k1", "b", http.StatusUnprocessableEntity, "IDEMPOTENCY_KEY_REUSED"},
+		{"key with space", "bad key", "a", http.StatusBadRequest, "IDEMPOTENCY_KEY_INVALID"},
+		{"key too long", strings.Repeat("k", maxIdempotencyKeyLength+1), "a", http.StatusBadRequest, "IDEMPOTENCY_KEY_INVALID"},
+		{"body over limit", "k2", strings.Repeat("x", 65), http.StatusRequestEntityTooLarge, "BODY_TOO_LARGE"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it := newIdempotencyTest(t)
+			it.do(http.MethodPost, "k1", "a", "req-1")
+
+			rec := it.do(http.MethodPost, tt.key, tt.body, "req-2")
+			if rec.Code != tt.wantStatus || !strings.Contains(rec.Body.String(), tt.wantCode) {
+				t.Errorf("got %d %s, want %d %s", rec.Code, rec.Body.String(), tt.wantStatus, tt.wantCode)
+			}
+			if it.callCount() != 1 {
+				t.Errorf("handler calls = %d, want 1", it.callCount())
+			}
+		})
+	}
+}
+
+func TestIdempotencyMiddlewarePassThrough(t *testing.T) {
+	it := newIdempotencyTest(t)
+	it.do(http.MethodPost, "", "a", "req-1")
+	it.do(http.MethodPost, "", "a", "req-2")
+	it.do(http.MethodGet, "k1", "", "req-3")
+	it.do(http.MethodGet, "k1", "", "req-4")
+	if it.callCount() != 4 {
+		t.Errorf("handler calls = %d, want 4 (no key or non-mutating method)", it.callCount())
+	}
+}
+
+func TestIdempotencyMiddlewareReleasesFailedAttempt(t *testing.T) {
+	it := newIdempotencyTest(t)
+	for i := 0; i < 2; i++ {
+		if rec := it.do(http.MethodPost, "k1", "fail", "req"); rec.Code != http.StatusInternalServerError {
+			t.Fatalf("attempt %d status = %d, want 500", i, rec.Code)
+		}
+	}
+	if it.callCount() != 2 {
+		t.Errorf("handler calls = %d, want 2 (5xx is not stored)", it.callCount())
+	}
+}
+
+func TestIdempotencyMiddlewareConcurrentDuplicate(t *testing.T) {
+	it := newIdempotencyTest(t)
+
+	done := make(chan *httptest.ResponseRecorder)
+	go func() { done <- it.do(http.MethodPost, "k1", "wait:a", "req-1") }()
+	waitForCalls(t, it, 1)
+
+	rec := it.do(http.MethodPost, "k1", "wait:a", "req-2")
+	if rec.Code != http.StatusConflict || rec.Header().Get("Retry-After") == "" {
+		t.Errorf("in-flight duplicate = %d (Retry-After %q), want 409 with Retry-After", rec.Code, rec.Header().Get("Retry-After"))
+	}
+
+	it.open(1)
+	if first := <-done; first.Code != http.StatusCreated {
+		t.Errorf("first request status = %d, want 201", first.Code)
+	}
+	if rec := it.do(http.MethodPost, "k1", "wait:a", "req-3"); rec.Header().Get(IdempotentReplayedHeader) != "true" {
+		t.Errorf("retry after completion was not replayed: %d", rec.Code)
+	}
+}
+
+// 처리 중 잠금이 풀려 재시도가 key 를 넘겨받은 뒤 원래 요청이 실패해도 새 소유자의 처리 중 기록을 지우지 못함
+func TestIdempotencyMiddlewareTakeoverKeepsNewOwner(t *testing.T) {
+	it := newIdempotencyTest(t)
+
+	done := make(chan *httptest.ResponseRecorder)
+	go func() { done <- it.do(http.MethodPost, "k1", "wait:fail-first", "req-1") }()
+	waitForCalls(t, it, 1)
+
+	// lock_timeout 경과 → 재시도가 넘겨받아 처리 중
+	it.store.expire("user:7:k1")
+	go func() { done <- it.do(http.MethodPost, "k1", "wait:fail-first", "req-2") }()
+	waitForCalls(t, it, 2)
+
+	// 원래 요청이 500 으로 끝나도 key 는 재시도 소유로 남아 있어야 함
+	it.open(1)
+	if first := <-done; first.Code != http.StatusInternalServerError {
+		t.Fatalf("original attempt status = %d, want 500", first.Code)
+	}
+	if rec := it.do(http.MethodPost, "k1", "wait:fail-first", "req-3"); rec.Code != http.StatusConflict {
+		t.Errorf("attempt during takeover = %d, want 409 (key must still be held by the retry)", rec.Code)
+	}
+
+	it.open(2)
+	if second := <-done; second.Code != http.StatusCreated {
+		t.Fatalf("retry status = %d, want 201", second.Code)
+	}
+	if rec := it.do(http.MethodPost, "k1", "wait:fail-first", "req-4"); rec.Header().Get(IdempotentReplayedHeader) != "true" {
+		t.Errorf("attempt after retry completed = %d, want replay", rec.Code)
+	}
+	if it.callCount() != 2 {
+		t.Errorf("handler calls = %d, want 2", it.callCount())
+	}
+}
+
+func waitForCalls(t *testing.T, it *idempotencyTest, n int) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for it.callCount() < n {
+		if time.Now().After(deadline) {
+			t.Fatalf("handler calls = %d, want %d", it.callCount(), n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"server/internal/apperror"
+	"server/internal/clientip"
+	"server/internal/principal"
+	"server/internal/ratelimit"
+	"strconv"
+
+	"github.com/rs/zerolog/log"
+)
+
+// PolicyTokenReissue AuthMiddleware 의 refresh_token → access_token 재발급 제한 정책 이름
+const PolicyTokenReissue = "token_reissue"
+
+var errTooManyRequests = apperror.New(apperror.TypeTooManyRequests, "", "")
+
+// RateLimitMiddleware 라우트 그룹별 요청 빈도 제한 (한도 초과 시 429 + Retry-After)
+// 모든 응답에 RateLimit-Limit / RateLimit-Remaining / RateLimit-Reset / RateLimit-Policy 헤더 추가
+type RateLimitMiddleware struct {
+	limiter        *ratelimit.Limiter
+	trustedProxies int
+}
+
+// NewRateLimitMiddleware limiter 가 nil 이면(rate_limit.enabled=false) 모든 요청 통과
+func NewRateLimitMiddleware(limiter *ratelimit.Limiter, trustedProxies int) *RateLimitMiddleware {
+	return &RateLimitMiddleware{limiter: limiter, trustedProxies: trustedProxies}
+}
+
+// Policy rate_limit.policies.{name} 정책을 적용하는 미들웨어
+// user 기준 정책은 인증 미들웨어 안쪽에 걸어야 유저별로 나뉨 (m 이 nil 이거나 정책이 없으면 그대로 통과)
+func (m *RateLimitMiddleware) Policy(name string) func(http.Handler) http.Handler {
+	p, ok := m.policy(name)
+	if !ok {
+		return func(next http.Handler) http.Handler { return next }
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			d, ok := m.take(r, p)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+			setRateLimitHeaders(w.Header(), p, d)
+			if !d.Allowed {
+				writeTooManyRequests(w, r, p, d)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func (m *RateLimitMiddleware) policy(name string) (ratelimit.Policy, bool) {
+	if m == nil || m.limiter == nil {
+		return ratelimit.Policy{}, false
+	}
+	p, ok := m.limiter.Policy(name)
+	if !ok {
+		log.Error().Str("policy", name).Msg("[RateLimitMiddleware] rate limit policy not configured, requests are not limited")
+	}
+	return p, ok
+}
+
+// take 요청 키의 버킷에서 토큰 1개 사용
+// 저장소 오류면 false (가용성을 우선해 제한하지 않고 통과)
+func (m *RateLimitMiddleware) take(r *http.Request, p ratelimit.Policy) (ratelimit.Decision, bool) {
+	d, err := m.limiter.Take(r.Context(), p, m.key(r, p.KeyBy))
+	if err != nil {
+		log.Ctx(r.Context()).Error().Err(err).Str("policy", p.Name).Msg("[RateLimitMiddleware] take token failed, request not limited")
+		return ratelimit.Decision{}, false
+	}
+	if !d.Allowed {
+		log.Ctx(r.Context()).Warn().
+			Str("policy", p.Name).
+			Dur("retry_after", d.RetryAfter).
+			Msg("[RateLimitMiddleware] rate limit exceeded")
+	}
+	return d, true
+}
+
+// key 정책 기준별 버킷 키 (user 를 알 수 없으면 IP)
+func (m *RateLimitMiddleware) key(r *http.Request, by ratelimit.KeyBy) string {
+	switch by {
+	case ratelimit.KeyUser:
+		if userID, ok := principal.UserID(r.Context()); ok {
+			return "user:" + strconv.Itoa(userID)
+		}
+	}
+	return "ip:" + clientip.Behind(r, m.trustedProxies)
+}
+
+// setRateLimitHeaders IETF RateLimit 헤더 초안 형식
+func setRateLimitHeaders(h http.Header, p ratelimit.Policy, d ratelimit.Decision) {
+	h.Set("RateLimit-Limit", strconv.Itoa(d.Limit))
+	h.Set("RateLimit-Remaining", strconv.Itoa(d.Remaining))
+	h.Set("RateLimit-Reset", strconv.Itoa(ratelimit.Seconds(d.ResetAfter)))
+	h.Set("RateLimit-Policy", fmt.Sprintf("%d;w=%d", p.Burst, ratelimit.Seconds(p.Window())))
+}
+
+func writeTooManyRequests(w http.ResponseWriter, r *http.Request, p ratelimit.Policy, d ratelimit.Decision) {
+	retryAfter := ratelimit.Seconds(d.RetryAfter)
+	if retryAfter < 1 {
+		retryAfter = 1
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	apperror.Write(w, r, errTooManyRequests.
+		WithDetail("policy", p.Name).
+		WithDetail("retry_after_seconds", retryAfter))
+}
+
+// rateLimitedError AuthMiddleware 의 토큰 재발급이 한도에 걸린 경우 (401 대신 429 응답)
+type rateLimitedError struct {
+	policy   ratelimit.Policy
+	decision ratelimit.Decision
+}
+
+func (e *rateLimitedError) Error() string {
+	return "rate limit exceeded: " + e.policy.Name
+}
+
+// check Policy 미들웨어 없이 코드 안에서 한도 확인 (헤더는 거절된 경우에만 응답할 때 설정)
+func (m *RateLimitMiddleware) check(r *http.Request, name string) *rateLimitedError {
+	p, ok := m.policy(name)
+	if !ok {
+		return nil
+	}
+	d, ok := m.take(r, p)
+	if !ok || d.Allowed {
+		return nil
+	}
+	return &rateLimitedError{policy: p, decision: d}
+}
+
+func (e *rateLimitedError) write(w http.ResponseWriter, r *http.Request) {
+	setRateLimitHeaders(w.Header(), e.policy, e.decision)
+	writeTooManyRequests(w, r, e.policy, e.decision)
+}
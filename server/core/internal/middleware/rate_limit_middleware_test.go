@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"server/internal/principal"
+	"server/internal/ratelimit"
+	"testing"
+)
+
+func TestRateLimitKey(t *testing.T) {
+	withUser := func(ctx context.Context) context.Context {
+		return principal.NewContext(ctx, &principal.Principal{UserID: 7})
+	}
+
+	tests := []struct {
+		name string
+		by   ratelimit.KeyBy
+		ctx  func(context.Context) context.Context
+		want string
+	}{
+		{name: "ip", by: ratelimit.KeyIP, ctx: withUser, want: "ip:203.0.113.9"},
+		{name: "user", by: ratelimit.KeyUser, ctx: withUser, want: "user:7"},
+		{name: "anonymous user falls back to ip", by: ratelimit.KeyUser, want: "ip:203.0.113.9"},
+	}
+	m := NewRateLimitMiddleware(nil, 1)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/api/v1/users/me", nil)
+			r.RemoteAddr = "10.0.0.2:41234"
+			r.Header.Set("X-Forwarded-For", "203.0.113.9")
+			if tt.ctx != nil {
+				r = r.WithContext(tt.ctx(r.Context()))
+			}
+			if got := m.key(r, tt.by); got != tt.want {
+				t.Errorf("key = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
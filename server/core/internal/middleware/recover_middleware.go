@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/debug"
+	"server/internal/apperror"
+	"server/internal/crash"
+	"server/internal/requestid"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// NewRecoverMiddleware 핸들러 panic 을 복구해서 공통 JSON 500 응답 (접근 로그 바로 안쪽에서 실행)
+//
+// 스택 트레이스는 요청 로거(request_id, route, user_id 포함)로 남기고,
+// reporter 가 있으면 크래시 리포트도 전송 (nil 이면 로그만)
+func NewRecoverMiddleware(reporter *crash.Reporter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rw := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+			defer func() {
+				rec := recover()
+				if rec == nil {
+					return
+				}
+				// 클라이언트 연결 끊김 등 net/http 가 의도적으로 중단한 경우는 그대로 전달
+				if rec == http.ErrAbortHandler {
+					panic(rec)
+				}
+
+				report := crash.NewReport(rec, 1)
+				report.RequestID = requestid.FromContext(r.Context())
+				report.Method = r.Method
+				report.Path = r.URL.Path
+				report.Route = r.Pattern
+
+				log.Ctx(r.Context()).Error().
+					Str("panic", fmt.Sprint(rec)).
+					Str("fingerprint", report.Fingerprint).
+					Str("stack", string(debug.Stack())).
+					Msg("[RecoverMiddleware] recovered from panic")
+
+				if reporter != nil {
+					reporter.Capture(report)
+				}
+
+				// 이미 응답을 쓰기 시작했으면 상태 코드를 바꿀 수 없으므로 연결을 끊어 잘린 응답임을 알림
+				if rw.wroteHeader {
+					panic(http.ErrAbortHandler)
+				}
+				apperror.Write(rw, r, apperror.Internal(errors.Errorf("panic: %v", rec)))
+			}()
+
+			next.ServeHTTP(rw, r)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"net/http"
+	"server/internal/apperror"
+	"server/internal/principal"
+
+	"github.com/rs/zerolog/log"
+)
+
+// errForbiddenRole 필요한 역할이 없음 (details.required_role)
+var errForbiddenRole = apperror.Forbidden("ROLE_REQUIRED", "")
+
+// RequireRole AuthMiddleware 뒤에서 사용: Principal 이 해당 역할이 아니면 403
+func RequireRole(role string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			p, ok := principal.FromContext(r.Context())
+			if !ok {
+				apperror.Write(w, r, errUnauthenticated)
+				return
+			}
+			if !p.HasRole(role) {
+				log.Ctx(r.Context()).Warn().Str("role", p.Role).Str("required", role).Msg("[RequireRole] Forbidden")
+				apperror.Write(w, r, errForbiddenRole.WithDetail("required_role", role))
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"net/http"
+	"server/internal/config"
+	"strconv"
+)
+
+// NewSecurityHeadersMiddleware security_headers 설정의 보안 헤더를 모든 응답에 추가
+// 핸들러가 같은 헤더를 다시 설정하면 핸들러 값이 우선 (예: /api/v1/docs 의 CSP)
+func NewSecurityHeadersMiddleware(cfg *config.AppConfig) func(http.Handler) http.Handler {
+	c := cfg.SecurityHeaders
+	headers := map[string]string{
+		"X-Content-Type-Options": "nosniff",
+	}
+	if c.HSTSMaxAgeSeconds > 0 {
+		hsts := "max-age=" + strconv.Itoa(c.HSTSMaxAgeSeconds)
+		if c.HSTSIncludeSubdomains {
+			hsts += "; includeSubDomains"
+		}
+		if c.HSTSPreload {
+			hsts += "; preload"
+		}
+		headers["Strict-Transport-Security"] = hsts
+	}
+	if c.FrameOptions != "" {
+		headers["X-Frame-Options"] = c.FrameOptions
+	}
+	if c.ReferrerPolicy != "" {
+		headers["Referrer-Policy"] = c.ReferrerPolicy
+	}
+	if c.ContentSecurityPolicy != "" {
+		headers["Content-Security-Policy"] = c.ContentSecurityPolicy
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			h := w.Header()
+			for k, v := range headers {
+				h.Set(k, v)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
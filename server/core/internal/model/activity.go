@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+// DailyActiveCount 날짜별 활성 유저 수
+type DailyActiveCount struct {
+	Date  string `json:"date"` // YYYY-MM-DD
+	Count int    `json:"count"`
+}
+
+// ActivityMetrics 관리자 활동 지표 (기준일 포함 최근 1/7/30일)
+type ActivityMetrics struct {
+	Date     string             `json:"date"`
+	DAU      int                `json:"dau"`
+	WAU      int                `json:"wau"`
+	MAU      int                `json:"mau"`
+	NewUsers int                `json:"new_users"`
+	Daily    []DailyActiveCount `json:"daily"`
+}
+
+// UserDailyActivity 유저 1명의 하루 활동 (개인정보 내보내기용)
+type UserDailyActivity struct {
+	Date        string    `json:"date"` // YYYY-MM-DD (Asia/Seoul 기준)
+	FirstSeenAt time.Time `json:"first_seen_at"`
+	LastSeenAt  time.Time `json:"last_seen_at"`
+	LoginCount  int       `json:"login_count"`
+}
+
+// DateRange activity_date 기준 [From, To] (양 끝 포함)
+type DateRange struct {
+	From time.Time
+	To   time.Time
+}
@@ -0,0 +1,73 @@
+package model
+
+import "time"
+
+// users.status 값
+const (
+	UserStatusActive    = "ACTIVE"
+	UserStatusSuspended = "SUSPENDED" // suspended_until 까지 이용 정지
+	UserStatusBanned    = "BANNED"    // 영구 차단
+)
+
+// 정지/차단/탈퇴한 계정 요청 거부 시 응답 code
+const (
+	AccountSuspendedCode = "ACCOUNT_SUSPENDED"
+	AccountBannedCode    = "ACCOUNT_BANNED"
+	AccountDeletedCode   = "ACCOUNT_DELETED" // 탈퇴 유예 중이거나 익명화된 계정 (유예 중이면 재로그인으로 복구)
+)
+
+// admin_actions.action 값
+const (
+	AdminActionSuspend    = "SUSPEND"
+	AdminActionBan        = "BAN"
+	AdminActionReinstate  = "REINSTATE"
+	AdminActionChangeRole = "CHANGE_ROLE"
+	AdminActionAddNote    = "ADD_NOTE"
+)
+
+// AccountStatus 요청마다 확인하는 계정 상태 (AuthMiddleware 캐시 대상)
+type AccountStatus struct {
+	UserID         int        `json:"user_id"`
+	Role           string     `json:"role"`
+	Status         string     `json:"status"`
+	SuspendedUntil *time.Time `json:"suspended_until,omitempty"`
+	Reason         string     `json:"reason,omitempty"`
+	DeletedAt      *time.Time `json:"deleted_at,omitempty"`
+}
+
+// BlockCode 현재 이용이 막혀 있으면 응답 code, 아니면 빈 문자열 (정지 기간이 지나면 자동 해제)
+// 탈퇴한 계정은 정지/차단 여부와 관계없이 ACCOUNT_DELETED
+func (s *AccountStatus) BlockCode(now time.Time) string {
+	if s.DeletedAt != nil {
+		return AccountDeletedCode
+	}
+	switch s.Status {
+	case UserStatusBanned:
+		return AccountBannedCode
+	case UserStatusSuspended:
+		if s.SuspendedUntil == nil || now.Before(*s.SuspendedUntil) {
+			return AccountSuspendedCode
+		}
+	}
+	return ""
+}
+
+// AdminAction 관리자 조치 이력 (누가, 누구에게, 왜)
+type AdminAction struct {
+	ID           int                    `json:"id"`
+	AdminID      int                    `json:"admin_id"`
+	TargetUserID int                    `json:"target_user_id"`
+	Action       string                 `json:"action"`
+	Reason       string                 `json:"reason"`
+	Details      map[string]interface{} `json:"details"`
+	CreatedAt    time.Time              `json:"created_at"`
+}
+
+// AdminNote 유저에 대한 관리자 내부 메모 (유저에게 노출하지 않음)
+type AdminNote struct {
+	ID        int       `json:"id"`
+	UserID    int       `json:"user_id"`
+	AdminID   int       `json:"admin_id"`
+	Note      string    `json:"note"`
+	CreatedAt time.Time `json:"created_at"`
+}
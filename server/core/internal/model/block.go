@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// RestrictedUser 차단/뮤트 목록 항목 (상대 유저의 공개 프로필 + 차단/뮤트한 시각)
+type RestrictedUser struct {
+	RelationID   int       `json:"-"` // keyset 커서용 (user_blocks.id / user_mutes.id)
+	UserID       int       `json:"id"`
+	Nickname     string    `json:"nickname"`
+	DisplayName  string    `json:"display_name"`
+	ProfileImage string    `json:"profile_image"`
+	CreatedAt    time.Time `json:"created_at"`
+}
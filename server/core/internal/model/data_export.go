@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+const (
+	DataExportStatusPending = "PENDING"
+	DataExportStatusRunning = "RUNNING"
+	DataExportStatusReady   = "READY"
+	DataExportStatusFailed  = "FAILED"
+	DataExportStatusExpired = "EXPIRED"
+)
+
+// DataExport 개인정보 내보내기(takeout) 요청
+type DataExport struct {
+	ID           int        `json:"id"`
+	UserID       int        `json:"user_id"`
+	Status       string     `json:"status"`
+	FileKey      string     `json:"-"` // storage.BlobStore 키 (READY 일 때)
+	FileSize     int64      `json:"file_size,omitempty"`
+	ErrorMessage string     `json:"error_message,omitempty"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	CompletedAt  *time.Time `json:"completed_at,omitempty"`
+}
+
+// IsDownloadable 다운로드 가능한 상태이며 링크가 만료되지 않았는지
+func (e *DataExport) IsDownloadable(now time.Time) bool {
+	return e.Status == DataExportStatusReady && e.ExpiresAt != nil && now.Before(*e.ExpiresAt)
+}
@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+// follows.status 값
+const (
+	FollowStatusPending  = "PENDING" // 팔로우 승인제 유저에게 보낸 요청 (승인 전에는 팔로워로 세지 않음)
+	FollowStatusAccepted = "ACCEPTED"
+)
+
+// Follow FollowerID 가 FolloweeID 를 팔로우
+type Follow struct {
+	ID         int        `json:"id"`
+	FollowerID int        `json:"follower_id"`
+	FolloweeID int        `json:"followee_id"`
+	Status     string     `json:"status"`
+	CreatedAt  time.Time  `json:"created_at"`
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+}
+
+// FollowUser 팔로워/팔로잉/팔로우 요청 목록 항목 (상대 유저의 공개 프로필 + 팔로우 시각)
+type FollowUser struct {
+	FollowID     int       `json:"-"` // keyset 커서용
+	UserID       int       `json:"id"`
+	Nickname     string    `json:"nickname"`
+	DisplayName  string    `json:"display_name"`
+	ProfileImage string    `json:"profile_image"`
+	FollowedAt   time.Time `json:"followed_at"`
+}
+
+// FollowCounts 프로필에 표시하는 팔로워/팔로잉 수 (승인된 관계만)
+type FollowCounts struct {
+	Followers int `json:"followers_count"`
+	Following int `json:"following_count"`
+}
@@ -0,0 +1,44 @@
+package model
+
+import "time"
+
+const (
+	LegalDocTermsOfService = "TERMS_OF_SERVICE"
+	LegalDocPrivacyPolicy  = "PRIVACY_POLICY"
+	LegalDocMarketing      = "MARKETING"
+)
+
+// LegalDocument 버전이 있는 약관/방침 문서
+type LegalDocument struct {
+	ID          int       `json:"id"`
+	DocType     string    `json:"doc_type"`
+	Version     string    `json:"version"`
+	Title       string    `json:"title"`
+	ContentURL  string    `json:"content_url"`
+	Mandatory   bool      `json:"mandatory"`
+	EffectiveAt time.Time `json:"effective_at"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// UserConsent 유저가 특정 버전 문서에 동의한 기록
+type UserConsent struct {
+	ID         int       `json:"id"`
+	UserID     int       `json:"user_id"`
+	DocumentID int       `json:"document_id"`
+	DocType    string    `json:"doc_type"`
+	Version    string    `json:"version"`
+	AgreedAt   time.Time `json:"agreed_at"`
+	IPAddress  string    `json:"ip_address,omitempty"`
+	UserAgent  string    `json:"user_agent,omitempty"`
+}
+
+// MarketingConsent 마케팅 수신 동의/철회 이력 1건
+type MarketingConsent struct {
+	ID         int       `json:"id"`
+	UserID     int       `json:"user_id"`
+	DocumentID *int      `json:"document_id,omitempty"`
+	Agreed     bool      `json:"agreed"`
+	IPAddress  string    `json:"ip_address,omitempty"`
+	UserAgent  string    `json:"user_agent,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
@@ -0,0 +1,43 @@
+package model
+
+import "time"
+
+// notifications.type 값
+const (
+	NotificationTypeNewFollower      = "follow.new_follower"
+	NotificationTypeFollowRequest    = "follow.request"
+	NotificationTypeFollowAccepted   = "follow.accepted"
+	NotificationTypeComment          = "comment.new"
+	NotificationTypeJourneyMilestone = "journey.milestone"
+	NotificationTypeSecurity         = "security.event"
+	NotificationTypeDataExportReady  = "data_export.ready"
+)
+
+// security.event 알림의 payload.event 값
+const (
+	SecurityEventAccountRestored = "account_restored" // 탈퇴 유예 기간 중 계정 복구
+)
+
+// Notification 인앱 알림 (같은 GroupKey 의 읽지 않은 알림은 한 건으로 묶이고 GroupCount 증가)
+type Notification struct {
+	ID         int                    `json:"id"`
+	UserID     int                    `json:"-"`
+	Type       string                 `json:"type"`
+	ActorID    *int                   `json:"actor_id,omitempty"`
+	Payload    map[string]interface{} `json:"payload"`
+	GroupKey   string                 `json:"group_key,omitempty"`
+	GroupCount int                    `json:"group_count"`
+	ReadAt     *time.Time             `json:"read_at,omitempty"`
+	CreatedAt  time.Time              `json:"created_at"`
+	UpdatedAt  time.Time              `json:"updated_at"`
+}
+
+// NotificationInput 다른 기능이 알림을 보낼 때 사용하는 입력
+type NotificationInput struct {
+	UserID  int    // 받는 유저
+	Type    string // NotificationType* 상수
+	ActorID int    // 알림을 발생시킨 유저 (없으면 0, 받는 유저와 차단 관계면 보내지 않음)
+	Payload map[string]interface{}
+	// GroupKey 비어 있지 않으면 같은 키의 읽지 않은 알림과 묶음 (Payload 는 최신 값으로 교체)
+	GroupKey string
+}
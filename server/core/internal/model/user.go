@@ -2,15 +2,114 @@ package model
 
 import "time"
 
+// users.role 값
+const (
+	RoleUser  = "USER"
+	RoleAdmin = "ADMIN"
+)
+
 type User struct {
-	ID            int       `json:"id"`
-	OauthProvider string    `json:"oauth_provider"`
-	Email         string    `json:"email"`
-	Name          string    `json:"name"`
-	Nickname      string    `json:"nickname"`
-	ProfileImage  string    `json:"profile_image"`
-	Role          string    `json:"role"`
-	VisitsCount   int64     `json:"visits_count"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
+	ID             int        `json:"id"`
+	OauthProvider  string     `json:"oauth_provider"`
+	Email          string     `json:"email"`
+	Name           string     `json:"name"`
+	Nickname       string     `json:"nickname"`
+	Handle         string     `json:"handle"` // 공개 프로필 URL (/profiles/{handle}), 가입 시 user{id}
+	ProfileImage   string     `json:"profile_image"`
+	AvatarKey      string     `json:"-"` // 저장소에 올린 프로필 이미지 키 접두사 (비어 있으면 제공자 URL)
+	DisplayName    string     `json:"display_name"`
+	Bio            string     `json:"bio"`
+	Role           string     `json:"role"`
+	Status         string     `json:"status"`
+	SuspendedUntil *time.Time `json:"suspended_until,omitempty"`
+	StatusReason   string     `json:"status_reason,omitempty"`
+	VisitsCount    int64      `json:"visits_count"` // OAuth 로그인 횟수
+	LastLoginAt    *time.Time `json:"last_login_at,omitempty"`
+	LastSeenAt     *time.Time `json:"last_seen_at,omitempty"` // 인증된 요청 기준 (몇 분 단위로 갱신)
+	OnboardedAt    *time.Time `json:"onboarded_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+	DeletedAt      *time.Time `json:"deleted_at,omitempty"`
+	PurgeAfter     *time.Time `json:"purge_after,omitempty"`   // 이 시각 이후 익명화 대상
+	AnonymizedAt   *time.Time `json:"anonymized_at,omitempty"` // 익명화 완료 시각
+}
+
+// AccountStatus 정지/차단/탈퇴 여부 확인용
+func (u *User) AccountStatus() *AccountStatus {
+	return &AccountStatus{
+		UserID:         u.ID,
+		Role:           u.Role,
+		Status:         u.Status,
+		SuspendedUntil: u.SuspendedUntil,
+		Reason:         u.StatusReason,
+		DeletedAt:      u.DeletedAt,
+	}
+}
+
+// NeedsOnboarding 첫 로그인 후 온보딩을 아직 마치지 않았는지
+func (u *User) NeedsOnboarding() bool {
+	return u.OnboardedAt == nil
+}
+
+// IsPendingDeletion 탈퇴 요청 후 유예 기간 중인지 (아직 익명화되지 않아 복구 가능)
+func (u *User) IsPendingDeletion() bool {
+	return u.DeletedAt != nil && u.AnonymizedAt == nil
+}
+
+// UserUpdate 부분 수정용: nil 이 아닌 필드만 UPDATE 에 포함 (동시에 다른 필드를 바꾼 요청을 덮어쓰지 않음)
+type UserUpdate struct {
+	Name         *string
+	Nickname     *string
+	DisplayName  *string
+	Bio          *string
+	ProfileImage *string
+	AvatarKey    *string
+	Role         *string
+
+	// 설정하면 users.updated_at 이 이 값과 같을 때만 수정 (낙관적 동시성 제어), 다르면 ErrConflict
+	ExpectedUpdatedAt *time.Time
+}
+
+// IsEmpty 수정할 필드가 하나도 없는지
+func (u UserUpdate) IsEmpty() bool {
+	return u.Name == nil && u.Nickname == nil && u.DisplayName == nil && u.Bio == nil &&
+		u.ProfileImage == nil && u.AvatarKey == nil && u.Role == nil
+}
+
+// UserFilter 유저 목록 조회 조건 (빈 값/nil 은 조건 없음)
+type UserFilter struct {
+	OauthProvider  string
+	Role           string
+	CreatedFrom    *time.Time // 이상
+	CreatedTo      *time.Time // 미만
+	EmailPrefix    string     // 대소문자 무시
+	NicknamePrefix string
+}
+
+// UserSearch 유저 검색 조건
+type UserSearch struct {
+	Query string
+	// 관리자 검색: 이름/이메일까지 검색 (일반 유저는 닉네임/표시 이름만)
+	IncludePrivate bool
+	// 검색하는 유저 (차단 관계인 유저는 결과에서 제외, 0 이면 제외하지 않음)
+	ViewerID int
+	Limit    int
+}
+
+// UserSearchResult 검색 결과 1건 (Score 가 클수록 관련도 높음)
+type UserSearchResult struct {
+	User  User
+	Score float64
+}
+
+// HandleChange 이전에 사용한 handle (예전 프로필 URL 리다이렉트용)
+type HandleChange struct {
+	Handle    string    `json:"handle"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// HandlePolicy handle 변경 제한 (한 계정이 handle 을 계속 바꿔 여러 개를 선점하지 못하도록)
+type HandlePolicy struct {
+	Cooldown    time.Duration // 마지막 변경 후 다시 바꿀 수 있을 때까지 기간
+	Reservation time.Duration // 이전 handle 을 예약(리다이렉트)하는 기간, 지나면 다른 유저가 사용 가능
 }
@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// UserIdentity OAuth 제공자 계정과 users 레코드의 연결 정보
+type UserIdentity struct {
+	ID             int       `json:"id"`
+	UserID         int       `json:"user_id"`
+	Provider       string    `json:"provider"`
+	ProviderUserID string    `json:"provider_user_id"`
+	AccessToken    string    `json:"-"`
+	RefreshToken   string    `json:"-"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
@@ -0,0 +1,241 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"server/pkg/validator"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage(nil))
+	// 제네릭 타입 이름의 타입 인자 (예: Page[server/internal/model.User] → server/internal/model.User)
+	typeArgPattern = regexp.MustCompile(`[\w./]+`)
+)
+
+// Schemas Go 타입을 JSON Schema 로 변환하고 이름 있는 구조체는 components/schemas 에 등록
+// 같은 타입은 한 번만 등록해 $ref 로 참조 (재귀 구조체도 처리됨)
+type Schemas struct {
+	components map[string]*Schema
+	names      map[reflect.Type]string
+}
+
+func NewSchemas() *Schemas {
+	return &Schemas{
+		components: make(map[string]*Schema),
+		names:      make(map[reflect.Type]string),
+	}
+}
+
+// Components 지금까지 등록된 컴포넌트 스키마
+func (s *Schemas) Components() map[string]*Schema {
+	return s.components
+}
+
+// Of 값의 타입으로 스키마 생성 (v 는 보통 zero value, 예: model.User{})
+func (s *Schemas) Of(v interface{}) *Schema {
+	if v == nil {
+		return &Schema{}
+	}
+	return s.schemaOf(reflect.TypeOf(v))
+}
+
+// Named 이름 있는 구조체를 타입 이름 대신 name 으로 등록 (예: apperror.Response → ErrorResponse)
+// 같은 타입을 Of 로 처음 만나기 전에 호출해야 함
+func (s *Schemas) Named(name string, v interface{}) *Schema {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if _, ok := s.names[t]; !ok {
+		s.names[t] = name
+		s.components[name] = s.structSchema(t)
+	}
+	return &Schema{Ref: "#/components/schemas/" + s.names[t]}
+}
+
+func (s *Schemas) schemaOf(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case rawMessageType:
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: s.schemaOf(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: s.schemaOf(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return s.structSchema(t)
+		}
+		return s.ref(t)
+	}
+	// interface{} 등 형태를 알 수 없는 값
+	return &Schema{}
+}
+
+// ref 이름 있는 구조체를 컴포넌트로 등록하고 $ref 반환
+func (s *Schemas) ref(t reflect.Type) *Schema {
+	name, ok := s.names[t]
+	if !ok {
+		name = s.componentName(t)
+		s.names[t] = name
+		s.components[name] = &Schema{} // 재귀 참조 대비 먼저 자리 확보
+		s.components[name] = s.structSchema(t)
+	}
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// componentName 타입 이름 (제네릭은 Page_User 형태), 다른 패키지에 같은 이름이 있으면 패키지 이름을 붙임
+func (s *Schemas) componentName(t reflect.Type) string {
+	name := t.Name()
+	if i := strings.IndexByte(name, '['); i >= 0 {
+		args := typeArgPattern.FindAllString(name[i:], -1)
+		name = name[:i]
+		for _, arg := range args {
+			name += "_" + arg[strings.LastIndexAny(arg, "./")+1:]
+		}
+	}
+	if _, taken := s.components[name]; taken {
+		pkg := t.PkgPath()
+		name = pkg[strings.LastIndexByte(pkg, '/')+1:] + "." + name
+	}
+	return name
+}
+
+func (s *Schemas) structSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	s.addFields(schema, t)
+	return schema
+}
+
+// addFields encoding/json 과 같은 규칙으로 필드 이름을 정하고, 임베드된 구조체 필드는 펼쳐서 추가
+func (s *Schemas) addFields(schema *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				s.addFields(schema, ft)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		omitempty := strings.Contains(opts, "omitempty")
+
+		prop := s.schemaOf(f.Type)
+		validateTag, hasValidate := f.Tag.Lookup("validate")
+		required, rules := validator.ParseTag(validateTag)
+		applyRules(prop, rules)
+
+		if f.Type.Kind() == reflect.Pointer && !omitempty {
+			prop = nullable(prop)
+		}
+		schema.Properties[name] = prop
+
+		// validate 태그가 있으면 required 규칙 기준 (요청 본문),
+		// 없으면 omitempty 가 아닌 필드는 항상 응답에 포함되므로 required
+		if required || (!hasValidate && !omitempty && f.Type.Kind() != reflect.Pointer) {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
+
+// applyRules validator 태그 규칙을 JSON Schema 키워드로 표현 (도메인 규칙 nickname, handle 등은 설명으로)
+func applyRules(schema *Schema, rules []validator.Rule) {
+	var custom []string
+	for _, rule := range rules {
+		switch rule.Name {
+		case "min", "max":
+			n, err := strconv.ParseFloat(rule.Param, 64)
+			if err != nil {
+				continue
+			}
+			applyBound(schema, rule.Name == "min", n)
+		case "email":
+			schema.Format = "email"
+		case "enum":
+			schema.Enum = strings.Split(rule.Param, "|")
+		case "regex":
+			schema.Pattern = rule.Param
+		default:
+			custom = append(custom, rule.Name)
+		}
+	}
+	if len(custom) > 0 {
+		schema.Description = "검증 규칙: " + strings.Join(custom, ", ")
+	}
+}
+
+func applyBound(schema *Schema, isMin bool, n float64) {
+	i := int(n)
+	switch schema.Type {
+	case "string":
+		if isMin {
+			schema.MinLength = &i
+		} else {
+			schema.MaxLength = &i
+		}
+	case "array":
+		if isMin {
+			schema.MinItems = &i
+		} else {
+			schema.MaxItems = &i
+		}
+	case "integer", "number":
+		if isMin {
+			schema.Minimum = &n
+		} else {
+			schema.Maximum = &n
+		}
+	}
+}
+
+// nullable JSON Schema 2020-12 방식 (OpenAPI 3.1 에서 nullable 키워드 대신 사용)
+func nullable(schema *Schema) *Schema {
+	if t, ok := schema.Type.(string); ok {
+		schema.Type = []string{t, "null"}
+		return schema
+	}
+	if schema.Ref != "" {
+		return &Schema{OneOf: []*Schema{schema, {Type: "null"}}}
+	}
+	return schema
+}
@@ -0,0 +1,247 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"server/internal/apperror"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+// ErrInvalidRequest limit/sort/cursor 파라미터 오류 (400, 구체적인 사유는 details.reason)
+var ErrInvalidRequest = apperror.BadRequest("INVALID_PAGINATION", "페이지네이션 파라미터가 올바르지 않습니다.")
+
+func invalidRequest(reason string) error {
+	return ErrInvalidRequest.WithDetail("reason", reason)
+}
+
+// ValueKind 정렬 컬럼 값의 타입 (커서 복원 시 사용)
+type ValueKind int
+
+const (
+	KindInt ValueKind = iota
+	KindString
+	KindTime
+)
+
+// SortField 정렬 허용 컬럼 (같은 값이 여러 행일 수 있으므로 항상 id 를 보조 키로 사용)
+type SortField struct {
+	Column string
+	Kind   ValueKind
+}
+
+// Whitelist 쿼리 파라미터 이름 → 정렬 컬럼 (목록에 없는 컬럼으로는 정렬 불가)
+type Whitelist map[string]SortField
+
+// Sort 파싱된 정렬 조건, 쿼리 파라미터로는 "created_at"(오름차순) / "-created_at"(내림차순)
+type Sort struct {
+	Name  string
+	Field SortField
+	Desc  bool
+}
+
+func (s Sort) String() string {
+	if s.Desc {
+		return "-" + s.Name
+	}
+	return s.Name
+}
+
+// Cursor 마지막으로 내려준 행의 정렬 키 (클라이언트에는 base64 로 인코딩한 불투명 문자열로 전달)
+type Cursor struct {
+	Sort  string          `json:"s"`
+	Value json.RawMessage `json:"v"`
+	ID    int             `json:"id"`
+}
+
+// Request 목록 조회 공통 파라미터
+type Request struct {
+	Limit  int
+	Sort   Sort
+	Cursor *Cursor
+}
+
+// Page 한 페이지 결과 (NextCursor 가 비어 있으면 마지막 페이지)
+type Page[T any] struct {
+	Items      []T    `json:"items"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// ParseRequest limit, sort, cursor 쿼리 파라미터 파싱
+// 다른 정렬 조건으로 만든 커서는 거부 (정렬을 바꾸면 처음부터 다시 조회)
+func ParseRequest(q url.Values, whitelist Whitelist, defaultSort string) (Request, error) {
+	req := Request{Limit: DefaultLimit}
+
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 || limit > MaxLimit {
+			return Request{}, invalidRequest(fmt.Sprintf("limit must be between 1 and %d", MaxLimit))
+		}
+		req.Limit = limit
+	}
+
+	sortParam := q.Get("sort")
+	if sortParam == "" {
+		sortParam = defaultSort
+	}
+	s, err := whitelist.parse(sortParam)
+	if err != nil {
+		return Request{}, err
+	}
+	req.Sort = s
+
+	if v := q.Get("cursor"); v != "" {
+		cursor, err := DecodeCursor(v)
+		if err != nil {
+			return Request{}, err
+		}
+		if cursor.Sort != s.String() {
+			return Request{}, invalidRequest("cursor was issued for a different sort")
+		}
+		req.Cursor = cursor
+	}
+	return req, nil
+}
+
+func (w Whitelist) parse(param string) (Sort, error) {
+	name := strings.TrimPrefix(param, "-")
+	field, ok := w[name]
+	if !ok {
+		allowed := make([]string, 0, len(w))
+		for k := range w {
+			allowed = append(allowed, k)
+		}
+		sort.Strings(allowed)
+		return Sort{}, invalidRequest(fmt.Sprintf("unsupported sort %q (allowed: %s)", param, strings.Join(allowed, ", ")))
+	}
+	return Sort{Name: name, Field: field, Desc: strings.HasPrefix(param, "-")}, nil
+}
+
+// EncodeCursor 커서를 URL 에 그대로 넣을 수 있는 문자열로 인코딩
+func EncodeCursor(c Cursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func DecodeCursor(s string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, invalidRequest("malformed cursor")
+	}
+	var c Cursor
+	if err := json.Unmarshal(b, &c); err != nil || len(c.Value) == 0 {
+		return nil, invalidRequest("malformed cursor")
+	}
+	return &c, nil
+}
+
+// FetchLimit 다음 페이지 존재 여부 확인을 위해 1건 더 조회
+func (r Request) FetchLimit() int {
+	return r.Limit + 1
+}
+
+// OrderBy ORDER BY 절 (컬럼명은 Whitelist 에서만 오므로 문자열 결합해도 안전)
+func (r Request) OrderBy() string {
+	dir := "ASC"
+	if r.Sort.Desc {
+		dir = "DESC"
+	}
+	return fmt.Sprintf("%s %s, id %s", r.Sort.Field.Column, dir, dir)
+}
+
+// KeysetCondition 커서 이후 행만 조회하는 WHERE 조건 (커서가 없으면 빈 문자열)
+// nextArg 는 이 조건에서 사용할 첫 번째 placeholder 번호 ($nextArg, $nextArg+1)
+func (r Request) KeysetCondition(nextArg int) (string, []interface{}, error) {
+	if r.Cursor == nil {
+		return "", nil, nil
+	}
+	value, err := r.cursorValue()
+	if err != nil {
+		return "", nil, err
+	}
+	op := ">"
+	if r.Sort.Desc {
+		op = "<"
+	}
+	cond := fmt.Sprintf("(%s, id) %s ($%d, $%d)", r.Sort.Field.Column, op, nextArg, nextArg+1)
+	return cond, []interface{}{value, r.Cursor.ID}, nil
+}
+
+func (r Request) cursorValue() (interface{}, error) {
+	raw := r.Cursor.Value
+	switch r.Sort.Field.Kind {
+	case KindInt:
+		var v int64
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, invalidRequest("malformed cursor value")
+		}
+		return v, nil
+	case KindTime:
+		var v time.Time
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, invalidRequest("malformed cursor value")
+		}
+		return v, nil
+	default:
+		var v string
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, invalidRequest("malformed cursor value")
+		}
+		return v, nil
+	}
+}
+
+// NewPage FetchLimit 만큼 조회한 결과로 페이지 구성
+// keyOf 는 행에서 현재 정렬 컬럼 값과 id 를 꺼내는 함수
+func NewPage[T any](rows []T, req Request, keyOf func(item T, sortName string) (interface{}, int)) Page[T] {
+	if len(rows) <= req.Limit {
+		if rows == nil {
+			rows = []T{}
+		}
+		return Page[T]{Items: rows}
+	}
+
+	items := rows[:req.Limit]
+	value, id := keyOf(items[len(items)-1], req.Sort.Name)
+	raw, _ := json.Marshal(value)
+	return Page[T]{
+		Items: items,
+		NextCursor: EncodeCursor(Cursor{
+			Sort:  req.Sort.String(),
+			Value: raw,
+			ID:    id,
+		}),
+	}
+}
+
+// CollectAll 커서를 따라가며 전체 목록 수집 (개인정보 내보내기처럼 한 번에 전부 필요한 경우)
+// list 는 repository 목록 조회 함수, keyOf 는 NewPage 에 넘기는 정렬 키 함수
+func CollectAll[T any](s Sort, keyOf func(item T, sortName string) (interface{}, int), list func(Request) ([]T, error)) ([]T, error) {
+	req := Request{Limit: MaxLimit, Sort: s}
+	all := []T{}
+	for {
+		rows, err := list(req)
+		if err != nil {
+			return nil, err
+		}
+		page := NewPage(rows, req, keyOf)
+		all = append(all, page.Items...)
+		if page.NextCursor == "" {
+			return all, nil
+		}
+		cursor, err := DecodeCursor(page.NextCursor)
+		if err != nil {
+			return nil, err
+		}
+		req.Cursor = cursor
+	}
+}
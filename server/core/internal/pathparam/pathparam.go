@@ -0,0 +1,54 @@
+// Package pathparam 라우트 패턴의 경로 파라미터({id}, {handle}, {key...})를 타입별로 꺼내는 헬퍼
+package pathparam
+
+import (
+	"net/http"
+	"server/internal/apperror"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// ErrInvalid 파라미터가 없거나 형식이 맞지 않는 경우 (400)
+var ErrInvalid = apperror.BadRequest("INVALID_PATH_PARAMETER", "경로 파라미터가 올바르지 않습니다.")
+
+// String 비어 있지 않은 문자열 파라미터
+func String(r *http.Request, name string) (string, error) {
+	v := r.PathValue(name)
+	if v == "" {
+		return "", errors.Wrapf(ErrInvalid.WithDetail("param", name), "[pathparam.String] %s is empty", name)
+	}
+	return v, nil
+}
+
+// Int 정수 파라미터
+func Int(r *http.Request, name string) (int, error) {
+	raw := r.PathValue(name)
+	v, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, errors.Wrapf(ErrInvalid.WithDetail("param", name), "[pathparam.Int] %s=%q is not an integer", name, raw)
+	}
+	return v, nil
+}
+
+// Int64 64비트 정수 파라미터
+func Int64(r *http.Request, name string) (int64, error) {
+	raw := r.PathValue(name)
+	v, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(ErrInvalid.WithDetail("param", name), "[pathparam.Int64] %s=%q is not an integer", name, raw)
+	}
+	return v, nil
+}
+
+// ID DB 식별자 파라미터 (양의 정수만 허용, 0 은 "비로그인" 등 특수값으로 쓰이므로 거부)
+func ID(r *http.Request, name string) (int, error) {
+	v, err := Int(r, name)
+	if err != nil {
+		return 0, err
+	}
+	if v <= 0 {
+		return 0, errors.Wrapf(ErrInvalid.WithDetail("param", name), "[pathparam.ID] %s=%d is not a positive id", name, v)
+	}
+	return v, nil
+}
@@ -0,0 +1,53 @@
+package preference
+
+import "server/pkg/validator"
+
+// 설정 키 (점으로 구분한 평면 키, 예: "notifications.email")
+const (
+	KeyLocale                   = "locale"
+	KeyTimezone                 = "timezone"
+	KeyTheme                    = "theme"
+	KeyNotificationsEmail       = "notifications.email"
+	KeyNotificationsPush        = "notifications.push"
+	KeyNotificationsNewFollower = "notifications.new_follower"
+	KeyNotificationsFollowReq   = "notifications.follow_request"
+	KeyNotificationsComment     = "notifications.comment"
+	KeyNotificationsMilestone   = "notifications.journey_milestone"
+	KeyJourneyDefaultVisibility = "journey.default_visibility"
+	KeyPrivacyFollowApproval    = "privacy.follow_approval"
+	KeyProfileVisibility        = "profile.visibility"
+	KeyProfileShowDisplayName   = "profile.show_display_name"
+	KeyProfileShowBio           = "profile.show_bio"
+	KeyProfileShowStats         = "profile.show_stats"
+)
+
+// 여정/프로필 공개 범위
+const (
+	VisibilityPublic    = "public"
+	VisibilityFollowers = "followers"
+	VisibilityPrivate   = "private"
+)
+
+// NewDefaultRegistry 서비스 전체에서 사용하는 설정 스키마
+func NewDefaultRegistry() *Registry {
+	v := validator.NewValidator()
+	return NewRegistry(
+		Enum(KeyLocale, "언어", "ko-KR", validator.SupportedLocales...),
+		String(KeyTimezone, "타임존 (IANA 이름, 예: Asia/Seoul)", "Asia/Seoul", v.ValidateTimezone),
+		Enum(KeyTheme, "화면 테마", "system", "system", "light", "dark"),
+		Bool(KeyNotificationsEmail, "이메일 알림 수신", true),
+		Bool(KeyNotificationsPush, "푸시 알림 수신", true),
+		Bool(KeyNotificationsNewFollower, "새 팔로워 알림", true),
+		Bool(KeyNotificationsFollowReq, "팔로우 요청/승인 알림", true),
+		Bool(KeyNotificationsComment, "새 댓글 알림", true),
+		Bool(KeyNotificationsMilestone, "여정 마일스톤 알림", true),
+		Enum(KeyJourneyDefaultVisibility, "새 여정의 기본 공개 범위", VisibilityPublic,
+			VisibilityPublic, VisibilityFollowers, VisibilityPrivate),
+		Bool(KeyPrivacyFollowApproval, "팔로우 요청 승인 후 팔로워로 추가", false),
+		Enum(KeyProfileVisibility, "공개 프로필 상세 정보 공개 범위", VisibilityPublic,
+			VisibilityPublic, VisibilityFollowers, VisibilityPrivate),
+		Bool(KeyProfileShowDisplayName, "공개 프로필에 표시 이름 노출", true),
+		Bool(KeyProfileShowBio, "공개 프로필에 소개 노출", true),
+		Bool(KeyProfileShowStats, "공개 프로필에 팔로워/팔로잉 수와 가입일 노출", true),
+	)
+}
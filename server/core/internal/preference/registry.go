@@ -0,0 +1,126 @@
+package preference
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// Definition 설정 키 1개의 스키마 (기본값 + 검증)
+type Definition struct {
+	Key         string
+	Description string
+	Default     interface{}
+	// parse JSON 값을 검증하고 저장할 값으로 변환, 실패 시 reason 반환
+	parse func(raw json.RawMessage) (interface{}, string)
+}
+
+// Parse 요청 JSON 값 검증 (reason 이 비어 있지 않으면 실패)
+func (d Definition) Parse(raw json.RawMessage) (interface{}, string) {
+	return d.parse(raw)
+}
+
+// Bool true/false 설정
+func Bool(key, description string, def bool) Definition {
+	return Definition{
+		Key:         key,
+		Description: description,
+		Default:     def,
+		parse: func(raw json.RawMessage) (interface{}, string) {
+			var v bool
+			if err := json.Unmarshal(raw, &v); err != nil {
+				return nil, "true 또는 false 여야 합니다"
+			}
+			return v, ""
+		},
+	}
+}
+
+// Enum 허용된 문자열 중 하나
+func Enum(key, description, def string, allowed ...string) Definition {
+	return Definition{
+		Key:         key,
+		Description: description,
+		Default:     def,
+		parse: func(raw json.RawMessage) (interface{}, string) {
+			var v string
+			if err := json.Unmarshal(raw, &v); err == nil {
+				for _, a := range allowed {
+					if v == a {
+						return v, ""
+					}
+				}
+			}
+			return nil, "다음 중 하나여야 합니다: " + strings.Join(allowed, ", ")
+		},
+	}
+}
+
+// String validate 를 통과한 문자열 (validate 는 실패 사유를 반환, 통과면 빈 문자열)
+func String(key, description, def string, validate func(string) string) Definition {
+	return Definition{
+		Key:         key,
+		Description: description,
+		Default:     def,
+		parse: func(raw json.RawMessage) (interface{}, string) {
+			var v string
+			if err := json.Unmarshal(raw, &v); err != nil {
+				return nil, "문자열이어야 합니다"
+			}
+			if reason := validate(v); reason != "" {
+				return nil, reason
+			}
+			return v, ""
+		},
+	}
+}
+
+// Registry 알려진 설정 키 목록 (등록되지 않은 키는 저장 불가)
+type Registry struct {
+	defs map[string]Definition
+}
+
+func NewRegistry(defs ...Definition) *Registry {
+	r := &Registry{defs: make(map[string]Definition)}
+	for _, d := range defs {
+		r.Register(d)
+	}
+	return r
+}
+
+// Register 기능별 설정 키 추가 (중복 키는 프로그래밍 오류이므로 panic)
+func (r *Registry) Register(d Definition) {
+	if _, exists := r.defs[d.Key]; exists {
+		panic("preference: duplicate key " + d.Key)
+	}
+	r.defs[d.Key] = d
+}
+
+func (r *Registry) Lookup(key string) (Definition, bool) {
+	d, ok := r.defs[key]
+	return d, ok
+}
+
+// Definitions 키 이름 순으로 정렬된 전체 스키마
+func (r *Registry) Definitions() []Definition {
+	defs := make([]Definition, 0, len(r.defs))
+	for _, d := range r.defs {
+		defs = append(defs, d)
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].Key < defs[j].Key })
+	return defs
+}
+
+// Resolve 저장된 값 위에 기본값을 채운 전체 설정 (등록이 해제된 옛 키는 무시)
+func (r *Registry) Resolve(stored map[string]json.RawMessage) map[string]interface{} {
+	resolved := make(map[string]interface{}, len(r.defs))
+	for key, d := range r.defs {
+		resolved[key] = d.Default
+		if raw, ok := stored[key]; ok {
+			if v, reason := d.Parse(raw); reason == "" {
+				resolved[key] = v
+			}
+		}
+	}
+	return resolved
+}
@@ -0,0 +1,37 @@
+package preference
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDefaultRegistryLocaleTimezone(t *testing.T) {
+	r := NewDefaultRegistry()
+	tests := []struct {
+		key     string
+		raw     string
+		wantErr bool
+	}{
+		{KeyLocale, `"en-US"`, false},
+		{KeyLocale, `"fr-FR"`, true},
+		{KeyLocale, `1`, true},
+		{KeyTimezone, `"America/New_York"`, false},
+		{KeyTimezone, `"Mars/Olympus"`, true},
+		{KeyTimezone, `"Local"`, true},
+		{KeyTimezone, `true`, true},
+	}
+	for _, tt := range tests {
+		d, ok := r.Lookup(tt.key)
+		if !ok {
+			t.Fatalf("key %q is not registered", tt.key)
+		}
+		if _, reason := d.Parse(json.RawMessage(tt.raw)); (reason != "") != tt.wantErr {
+			t.Errorf("Parse(%s, %s) reason = %q, wantErr %v", tt.key, tt.raw, reason, tt.wantErr)
+		}
+	}
+
+	resolved := r.Resolve(map[string]json.RawMessage{KeyTimezone: json.RawMessage(`"Europe/London"`)})
+	if resolved[KeyLocale] != "ko-KR" || resolved[KeyTimezone] != "Europe/London" {
+		t.Errorf("resolved locale/timezone = %v / %v", resolved[KeyLocale], resolved[KeyTimezone])
+	}
+}
@@ -0,0 +1,67 @@
+package principal
+
+import "context"
+
+// AuthMethod 요청이 어떤 방식으로 인증되었는지 나타냄
+type AuthMethod string
+
+const (
+	// AuthMethodAccessToken access_token 쿠키의 JWT 로 인증
+	AuthMethodAccessToken AuthMethod = "access_token"
+	// AuthMethodRefreshToken access_token 이 없거나 만료되어 refresh_token 으로 재발급 후 인증
+	AuthMethodRefreshToken AuthMethod = "refresh_token"
+)
+
+// Principal 인증된 요청 주체 (AuthMiddleware 가 context 에 저장)
+type Principal struct {
+	UserID     int
+	Email      string
+	Role       string
+	AuthMethod AuthMethod
+	SessionID  int // refresh_tokens.id (세션 식별자), 알 수 없으면 0
+	Scopes     []string
+}
+
+// HasRole 주어진 역할을 가지고 있는지 확인
+func (p *Principal) HasRole(role string) bool {
+	return p != nil && p.Role == role
+}
+
+// HasScope 주어진 scope 를 가지고 있는지 확인
+func (p *Principal) HasScope(scope string) bool {
+	if p == nil {
+		return false
+	}
+	for _, s := range p.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// 다른 패키지의 string key 와 충돌하지 않도록 비공개 타입을 key 로 사용
+type contextKey struct{}
+
+// NewContext Principal 을 담은 새 context 반환
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, contextKey{}, p)
+}
+
+// FromContext context 에서 Principal 을 꺼냄 (익명 요청이면 ok=false)
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(contextKey{}).(*Principal)
+	if !ok || p == nil || p.UserID <= 0 {
+		return nil, false
+	}
+	return p, true
+}
+
+// UserID context 의 Principal 에서 userID 를 꺼냄 (익명 요청이면 ok=false)
+func UserID(ctx context.Context) (int, bool) {
+	p, ok := FromContext(ctx)
+	if !ok {
+		return 0, false
+	}
+	return p.UserID, true
+}
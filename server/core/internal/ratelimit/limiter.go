@@ -0,0 +1,98 @@
+package ratelimit
+
+import (
+	"context"
+	"server/internal/config"
+	"server/internal/db"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	BackendMemory   = "memory"
+	BackendPostgres = "postgres"
+
+	cleanupInterval = time.Minute
+)
+
+// Limiter 이름별 정책과 버킷 저장소
+type Limiter struct {
+	store    Store
+	policies map[string]Policy
+}
+
+func NewLimiter(store Store, policies []Policy) *Limiter {
+	l := &Limiter{store: store, policies: make(map[string]Policy, len(policies))}
+	for _, p := range policies {
+		l.policies[p.Name] = p
+	}
+	return l
+}
+
+// NewLimiterFromConfig rate_limit 설정으로 Limiter 생성 (enabled=false 면 nil → 제한 없음)
+func NewLimiterFromConfig(cfg *config.AppConfig, dbConn *db.DB) (*Limiter, error) {
+	if !cfg.RateLimit.Enabled {
+		return nil, nil
+	}
+
+	var store Store
+	switch cfg.RateLimit.Backend {
+	case "", BackendMemory:
+		store = NewMemoryStore()
+	case BackendPostgres:
+		store = NewPostgresStore(dbConn)
+	default:
+		return nil, errors.Errorf("[NewLimiterFromConfig] unknown rate limit backend: %s", cfg.RateLimit.Backend)
+	}
+
+	policies := make([]Policy, 0, len(cfg.RateLimit.Policies))
+	for name, pc := range cfg.RateLimit.Policies {
+		p := Policy{
+			Name:  name,
+			Rate:  pc.RatePerMinute / 60,
+			Burst: pc.Burst,
+			KeyBy: KeyBy(pc.Key),
+		}
+		if p.Rate <= 0 || p.Burst < 1 {
+			return nil, errors.Errorf("[NewLimiterFromConfig] policy %s needs rate_per_minute > 0 and burst >= 1", name)
+		}
+		switch p.KeyBy {
+		case KeyIP, KeyUser:
+		default:
+			return nil, errors.Errorf("[NewLimiterFromConfig] policy %s has unknown key: %q", name, pc.Key)
+		}
+		policies = append(policies, p)
+	}
+	return NewLimiter(store, policies), nil
+}
+
+// Policy 이름으로 정책 조회
+func (l *Limiter) Policy(name string) (Policy, bool) {
+	p, ok := l.policies[name]
+	return p, ok
+}
+
+// Take 정책별로 분리된 key 버킷에서 토큰 1개 사용
+func (l *Limiter) Take(ctx context.Context, p Policy, key string) (Decision, error) {
+	return l.store.Take(ctx, p.Name+":"+key, p)
+}
+
+// RunCleanupLoop 가득 찬 버킷을 주기적으로 삭제 (ctx 취소 시 종료)
+func (l *Limiter) RunCleanupLoop(ctx context.Context) {
+	ticker := time.NewTicker(cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("[Limiter.RunCleanupLoop] stopped")
+			return
+		case <-ticker.C:
+			if err := l.store.Cleanup(ctx); err != nil {
+				log.Error().Err(err).Msg("[Limiter.RunCleanupLoop] cleanup failed")
+			}
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore 인스턴스 메모리에 버킷 저장 (로컬, 단일 인스턴스용)
+type MemoryStore struct {
+	mu   sync.Mutex
+	tats map[string]time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{tats: make(map[string]time.Time)}
+}
+
+func (s *MemoryStore) Take(ctx context.Context, key string, p Policy) (Decision, error) {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tat := s.tats[key]
+	if tat.Before(now) {
+		tat = now
+	}
+	next := tat.Add(p.Interval())
+	if next.Sub(now) > p.Window() {
+		return denied(p, tat.Sub(now)), nil
+	}
+	s.tats[key] = next
+	return allowed(p, next.Sub(now)), nil
+}
+
+func (s *MemoryStore) Cleanup(ctx context.Context) error {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, tat := range s.tats {
+		if tat.Before(now) {
+			delete(s.tats, key)
+		}
+	}
+	return nil
+}
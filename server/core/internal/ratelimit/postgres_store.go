@@ -0,0 +1,62 @@
+package ratelimit
+
+import (
+	"context"
+	"server/internal/db"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+// PostgresStore rate_limit_buckets 테이블에 버킷 저장 (ECS 태스크 간 한도 공유)
+// 시각 계산은 모두 DB 의 NOW() 기준이라 인스턴스 간 시계 차이의 영향을 받지 않음
+type PostgresStore struct {
+	db *db.DB
+}
+
+func NewPostgresStore(dbConn *db.DB) *PostgresStore {
+	return &PostgresStore{db: dbConn}
+}
+
+func (s *PostgresStore) Take(ctx context.Context, key string, p Policy) (Decision, error) {
+	interval := p.Interval().Seconds()
+	window := p.Window().Seconds()
+
+	// 토큰이 남아 있을 때만 TAT 갱신 (남아 있지 않으면 WHERE 에 걸려 반환 행 없음)
+	var ahead float64
+	err := s.db.Pool.QueryRow(ctx, `
+		INSERT INTO rate_limit_buckets AS b (key, tat)
+		     VALUES ($1, NOW() + make_interval(secs => $2))
+		ON CONFLICT (key) DO UPDATE
+		        SET tat = GREATEST(b.tat, NOW()) + make_interval(secs => $2)
+		      WHERE GREATEST(b.tat, NOW()) + make_interval(secs => $2) <= NOW() + make_interval(secs => $3)
+		  RETURNING EXTRACT(EPOCH FROM (tat - NOW()))::float8
+	`, key, interval, window).Scan(&ahead)
+	if err == nil {
+		return allowed(p, seconds(ahead)), nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return Decision{}, errors.Wrapf(err, "[PostgresStore.Take] take token failed, key=%s", key)
+	}
+
+	if err := s.db.Pool.QueryRow(ctx, `
+		SELECT EXTRACT(EPOCH FROM (tat - NOW()))::float8
+		  FROM rate_limit_buckets
+		 WHERE key = $1
+	`, key).Scan(&ahead); err != nil {
+		return Decision{}, errors.Wrapf(err, "[PostgresStore.Take] select bucket failed, key=%s", key)
+	}
+	return denied(p, seconds(ahead)), nil
+}
+
+func (s *PostgresStore) Cleanup(ctx context.Context) error {
+	if _, err := s.db.Pool.Exec(ctx, `DELETE FROM rate_limit_buckets WHERE tat < NOW()`); err != nil {
+		return errors.Wrap(err, "[PostgresStore.Cleanup] delete expired buckets failed")
+	}
+	return nil
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
@@ -0,0 +1,82 @@
+// Package ratelimit 토큰 버킷 방식 요청 빈도 제한
+//
+// 버킷 상태는 GCRA(Generic Cell Rate Algorithm) 로 키마다 시각 하나(TAT, 이론적 다음 도착 시각)만 저장하므로
+// 인메모리/Postgres 저장소 모두 원자적인 단일 연산으로 처리 가능
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// KeyBy 버킷을 나누는 기준
+type KeyBy string
+
+const (
+	KeyIP   KeyBy = "ip"
+	KeyUser KeyBy = "user" // 비로그인 요청은 IP
+)
+
+// Policy 라우트 그룹별 제한 정책 (rate_limit.policies.{name})
+type Policy struct {
+	Name  string
+	Rate  float64 // 초당 충전되는 토큰 수
+	Burst int     // 버킷 크기 (연속으로 허용하는 최대 요청 수)
+	KeyBy KeyBy
+}
+
+// Interval 토큰 1개가 충전되는 시간
+func (p Policy) Interval() time.Duration {
+	return time.Duration(float64(time.Second) / p.Rate)
+}
+
+// Window 빈 버킷이 가득 차는 데 걸리는 시간
+func (p Policy) Window() time.Duration {
+	return p.Interval() * time.Duration(p.Burst)
+}
+
+// Decision 토큰 사용 결과 (RateLimit-* 헤더 값)
+type Decision struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// ResetAfter 버킷이 가득 찰 때까지 남은 시간
+	ResetAfter time.Duration
+	// RetryAfter 거절된 경우 다음 요청이 허용될 때까지 남은 시간
+	RetryAfter time.Duration
+}
+
+// Store 버킷 저장소 (인스턴스 단독이면 memory, ECS 태스크 간 공유하려면 postgres)
+type Store interface {
+	// Take key 버킷에서 토큰 1개 사용
+	Take(ctx context.Context, key string, p Policy) (Decision, error)
+	// Cleanup 가득 찬(더 이상 상태가 필요 없는) 버킷 삭제
+	Cleanup(ctx context.Context) error
+}
+
+// allowed 토큰 사용 후 TAT 가 현재보다 ahead 만큼 앞선 상태
+func allowed(p Policy, ahead time.Duration) Decision {
+	remaining := int((p.Window() - ahead) / p.Interval())
+	if remaining < 0 {
+		remaining = 0
+	}
+	return Decision{Allowed: true, Limit: p.Burst, Remaining: remaining, ResetAfter: ahead}
+}
+
+// denied 현재 TAT 가 ahead 만큼 앞서 있어 토큰이 없는 상태
+func denied(p Policy, ahead time.Duration) Decision {
+	retry := ahead + p.Interval() - p.Window()
+	if retry < 0 {
+		retry = 0
+	}
+	return Decision{Allowed: false, Limit: p.Burst, ResetAfter: ahead, RetryAfter: retry}
+}
+
+// Seconds 헤더용 초 단위 (올림, 최소 0)
+func Seconds(d time.Duration) int {
+	if d <= 0 {
+		return 0
+	}
+	return int(math.Ceil(d.Seconds()))
+}
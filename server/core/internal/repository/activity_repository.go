@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"server/internal/model"
+	"time"
+)
+
+// ActivityRepository 유저 활동 기록 및 DAU/WAU/MAU 집계
+type ActivityRepository interface {
+	// Touch users.last_seen_at 갱신 + 해당 날짜 활동 행 upsert (login 이면 login_count 증가)
+	Touch(ctx context.Context, userID int, date time.Time, at time.Time, login bool) error
+	CountActiveUsers(ctx context.Context, dates model.DateRange) (int, error)
+	ListDailyActiveUsers(ctx context.Context, dates model.DateRange) ([]model.DailyActiveCount, error)
+	CountNewUsers(ctx context.Context, from, to time.Time) (int, error)
+	// ListUserActivity 유저의 일일 활동 전체 (날짜 오름차순)
+	ListUserActivity(ctx context.Context, userID int) ([]model.UserDailyActivity, error)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"server/internal/model"
+	"time"
+)
+
+// AdminRepository 관리자 조치: 유저 변경과 조치 이력을 한 트랜잭션으로 기록
+type AdminRepository interface {
+	SetUserStatus(ctx context.Context, action *model.AdminAction, status string, suspendedUntil *time.Time) error
+	SetUserRole(ctx context.Context, action *model.AdminAction, role string) error
+	CreateNote(ctx context.Context, action *model.AdminAction, note *model.AdminNote) error
+	ListNotes(ctx context.Context, userID int) ([]model.AdminNote, error)
+	ListActions(ctx context.Context, targetUserID, limit int) ([]model.AdminAction, error)
+}
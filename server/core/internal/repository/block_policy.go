@@ -0,0 +1,30 @@
+package repository
+
+import "fmt"
+
+// 차단/뮤트 정책 조건: 다른 유저의 콘텐츠나 유저 목록을 조회하는 모든 쿼리에서 WHERE 절에 추가해 사용
+//
+//	cond := NotBlockedCondition("u.id", len(args)+1)
+//	args = append(args, viewerID)
+//
+// viewerArg 번 placeholder 에 조회하는 유저 ID 를 바인딩 (비로그인이면 0 → 아무도 제외하지 않음)
+
+// NotBlockedCondition userColumn 유저와 조회 유저 사이에 어느 방향이든 차단이 있으면 제외
+func NotBlockedCondition(userColumn string, viewerArg int) string {
+	return fmt.Sprintf(`NOT EXISTS (
+		SELECT 1
+		  FROM user_blocks b
+		 WHERE (b.blocker_id = $%[2]d AND b.blocked_id = %[1]s)
+		    OR (b.blocker_id = %[1]s AND b.blocked_id = $%[2]d)
+	)`, userColumn, viewerArg)
+}
+
+// NotMutedCondition 조회 유저가 뮤트한 userColumn 유저 제외 (피드 전용, 목록/검색/프로필에는 적용하지 않음)
+func NotMutedCondition(userColumn string, viewerArg int) string {
+	return fmt.Sprintf(`NOT EXISTS (
+		SELECT 1
+		  FROM user_mutes m
+		 WHERE m.muter_id = $%[2]d
+		   AND m.muted_id = %[1]s
+	)`, userColumn, viewerArg)
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"server/internal/model"
+	"server/internal/pagination"
+)
+
+// BlockRepository 유저 차단/뮤트 목록
+type BlockRepository interface {
+	// Block 차단 등록 + 양방향 팔로우 관계(요청 포함) 삭제를 한 트랜잭션으로 처리 (이미 차단했으면 그대로)
+	Block(ctx context.Context, blockerID, blockedID int) error
+	Unblock(ctx context.Context, blockerID, blockedID int) error
+	// IsBlockedEither 어느 한쪽이라도 상대를 차단했는지
+	IsBlockedEither(ctx context.Context, userA, userB int) (bool, error)
+	ListBlocked(ctx context.Context, blockerID int, page pagination.Request) ([]model.RestrictedUser, error)
+
+	Mute(ctx context.Context, muterID, mutedID int) error
+	Unmute(ctx context.Context, muterID, mutedID int) error
+	ListMuted(ctx context.Context, muterID int, page pagination.Request) ([]model.RestrictedUser, error)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"server/internal/model"
+)
+
+type ConsentRepository interface {
+	// 문서 종류별 현재 시행 중인 최신 버전
+	ListCurrentDocuments(ctx context.Context) ([]model.LegalDocument, error)
+	// 현재 버전 중 유저가 아직 동의하지 않은 필수 문서
+	ListPendingMandatory(ctx context.Context, userID int) ([]model.LegalDocument, error)
+	AcceptDocuments(ctx context.Context, userID int, documentIDs []int, ipAddress, userAgent string) error
+	ListUserConsents(ctx context.Context, userID int) ([]model.UserConsent, error)
+
+	RecordMarketingConsent(ctx context.Context, consent *model.MarketingConsent) error
+	// 가장 최근 마케팅 동의 상태 (기록이 없으면 nil, nil)
+	FindLatestMarketingConsent(ctx context.Context, userID int) (*model.MarketingConsent, error)
+	ListMarketingConsents(ctx context.Context, userID int) ([]model.MarketingConsent, error)
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"server/internal/model"
+	"time"
+)
+
+type DataExportRepository interface {
+	Create(ctx context.Context, userID int) (*model.DataExport, error)
+	FindByID(ctx context.Context, id int) (*model.DataExport, error)
+	FindInProgressByUserID(ctx context.Context, userID int) (*model.DataExport, error)
+	// ListResumable 생성을 시작하지 않았거나(PENDING) 점유 기한이 지난 RUNNING 요청
+	ListResumable(ctx context.Context, limit int) ([]model.DataExport, error)
+	ListExpired(ctx context.Context, now time.Time, limit int) ([]model.DataExport, error)
+	// Claim PENDING 이거나 점유 기한이 지난 RUNNING 요청을 lease 동안 RUNNING 으로 선점 (다른 인스턴스가 선점 중이거나 끝난 요청이면 false)
+	Claim(ctx context.Context, id int, lease time.Duration) (bool, error)
+	MarkReady(ctx context.Context, id int, fileKey string, fileSize int64, expiresAt time.Time) error
+	MarkFailed(ctx context.Context, id int, errMsg string) error
+	MarkExpired(ctx context.Context, id int) error
+}
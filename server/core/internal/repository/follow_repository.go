@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"server/internal/model"
+	"server/internal/pagination"
+)
+
+// FollowRepository 유저 간 팔로우 관계
+type FollowRepository interface {
+	// Create 이미 관계(요청 포함)가 있으면 새로 만들지 않고 기존 관계를 반환
+	Create(ctx context.Context, followerID, followeeID int, status string) (*model.Follow, error)
+	Find(ctx context.Context, followerID, followeeID int) (*model.Follow, error)
+	// Delete 팔로우 취소, 요청 취소, 요청 거절 공통 (관계가 없으면 ErrNotFound)
+	Delete(ctx context.Context, followerID, followeeID int) error
+	// Accept PENDING 요청 승인 (대기 중인 요청이 없으면 ErrNotFound)
+	Accept(ctx context.Context, followerID, followeeID int) (*model.Follow, error)
+
+	// viewerID 와 차단 관계인 유저는 목록에서 제외 (0 이면 제외하지 않음)
+	ListFollowers(ctx context.Context, userID, viewerID int, status string, page pagination.Request) ([]model.FollowUser, error)
+	ListFollowing(ctx context.Context, userID, viewerID int, page pagination.Request) ([]model.FollowUser, error)
+	Counts(ctx context.Context, userID int) (*model.FollowCounts, error)
+}
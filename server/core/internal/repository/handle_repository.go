@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"server/internal/model"
+	"time"
+)
+
+// HandleRepository 공개 프로필 @handle 조회/변경 및 변경 이력
+type HandleRepository interface {
+	// FindUserByHandle 현재 handle 로 유저 조회 (없으면 ErrNotFound)
+	FindUserByHandle(ctx context.Context, handle string) (*model.User, error)
+	// FindCurrentHandle 예약 기간 안의 예전 handle 을 쓰던 유저의 현재 handle (리다이렉트용, 없으면 ErrNotFound)
+	FindCurrentHandle(ctx context.Context, oldHandle string, reservation time.Duration) (string, error)
+	// ChangeHandle 이전 handle 을 이력에 남기고 변경
+	// 다른 유저가 쓰고 있거나 예약 기간 안의 handle 이면 ErrConflict, 마지막 변경 후 policy.Cooldown 이 지나지 않았으면 ErrTooSoon
+	ChangeHandle(ctx context.Context, userID int, handle string, policy model.HandlePolicy) (*model.User, error)
+	ListHistory(ctx context.Context, userID int) ([]model.HandleChange, error)
+}
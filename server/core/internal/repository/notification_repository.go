@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"server/internal/model"
+	"server/internal/pagination"
+)
+
+// NotificationRepository 인앱 알림함
+type NotificationRepository interface {
+	// Create GroupKey 가 같은 읽지 않은 알림이 있으면 그 행의 group_count 를 늘리고 payload 를 갱신
+	Create(ctx context.Context, n *model.Notification) (*model.Notification, error)
+	List(ctx context.Context, userID int, unreadOnly bool, page pagination.Request) ([]model.Notification, error)
+	CountUnread(ctx context.Context, userID int) (int, error)
+	// MarkRead 본인 알림이 아니면 ErrNotFound
+	MarkRead(ctx context.Context, userID, id int) error
+	MarkAllRead(ctx context.Context, userID int) (int64, error)
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"server/internal/db"
+	"server/internal/model"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type PostgresActivityRepository struct {
+	db *db.DB
+}
+
+func NewPostgresActivityRepository(dbConn *db.DB) *PostgresActivityRepository {
+	return &PostgresActivityRepository{db: dbConn}
+}
+
+func (r *PostgresActivityRepository) Touch(ctx context.Context, userID int, date time.Time, at time.Time, login bool) error {
+	loginIncrement := 0
+	if login {
+		loginIncrement = 1
+	}
+
+	if _, err := r.db.Pool.Exec(ctx, `
+		UPDATE users
+		   SET last_seen_at = GREATEST(COALESCE(last_seen_at, $2), $2)
+		 WHERE id = $1
+	`, userID, at); err != nil {
+		return errors.Wrap(err, "[ActivityRepository.Touch] update last_seen_at fail")
+	}
+
+	if _, err := r.db.Pool.Exec(ctx, `
+		INSERT INTO user_daily_activity (user_id, activity_date, first_seen_at, last_seen_at, login_count)
+		     VALUES ($1, $2, $3, $3, $4)
+		ON CONFLICT (user_id, activity_date) DO UPDATE
+		        SET last_seen_at = GREATEST(user_daily_activity.last_seen_at, EXCLUDED.last_seen_at),
+		            login_count = user_daily_activity.login_count + EXCLUDED.login_count
+	`, userID, date, at, loginIncrement); err != nil {
+		return errors.Wrap(err, "[ActivityRepository.Touch] upsert daily activity fail")
+	}
+	return nil
+}
+
+// CountActiveUsers 기간 내 하루라도 활동한 유저 수 (activity_date 인덱스 범위 조회)
+func (r *PostgresActivityRepository) CountActiveUsers(ctx context.Context, dates model.DateRange) (int, error) {
+	var count int
+	err := r.db.Pool.QueryRow(ctx, `
+		SELECT COUNT(DISTINCT user_id)
+		  FROM user_daily_activity
+		 WHERE activity_date BETWEEN $1 AND $2
+	`, dates.From, dates.To).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "[CountActiveUsers] queryRow scan fail")
+	}
+	return count, nil
+}
+
+func (r *PostgresActivityRepository) ListDailyActiveUsers(ctx context.Context, dates model.DateRange) ([]model.DailyActiveCount, error) {
+	rows, err := r.db.Pool.Query(ctx, `
+		SELECT to_char(d::date, 'YYYY-MM-DD'),
+		       COALESCE(a.cnt, 0)
+		  FROM generate_series($1::date, $2::date, INTERVAL '1 day') AS d
+		  LEFT JOIN (
+		        SELECT activity_date, COUNT(*) AS cnt
+		          FROM user_daily_activity
+		         WHERE activity_date BETWEEN $1 AND $2
+		         GROUP BY activity_date
+		       ) a ON a.activity_date = d::date
+		 ORDER BY d
+	`, dates.From, dates.To)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ListDailyActiveUsers] query failed")
+	}
+	defer rows.Close()
+
+	var results []model.DailyActiveCount
+	for rows.Next() {
+		var c model.DailyActiveCount
+		if err := rows.Scan(&c.Date, &c.Count); err != nil {
+			return nil, errors.Wrap(err, "[ListDailyActiveUsers] row scan failed")
+		}
+		results = append(results, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "[ListDailyActiveUsers] rows iteration error")
+	}
+	return results, nil
+}
+
+// CountNewUsers [from, to) 기간 가입자 수 (created_at 인덱스 범위 조회)
+func (r *PostgresActivityRepository) CountNewUsers(ctx context.Context, from, to time.Time) (int, error) {
+	var count int
+	err := r.db.Pool.QueryRow(ctx, `
+		SELECT COUNT(*)
+		  FROM users
+		 WHERE created_at >= $1
+		   AND created_at < $2
+	`, from, to).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "[CountNewUsers] queryRow scan fail")
+	}
+	return count, nil
+}
+
+func (r *PostgresActivityRepository) ListUserActivity(ctx context.Context, userID int) ([]model.UserDailyActivity, error) {
+	rows, err := r.db.Pool.Query(ctx, `
+		SELECT to_char(activity_date, 'YYYY-MM-DD'), first_seen_at, last_seen_at, login_count
+		  FROM user_daily_activity
+		 WHERE user_id = $1
+		 ORDER BY activity_date
+	`, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ListUserActivity] query failed")
+	}
+	defer rows.Close()
+
+	var results []model.UserDailyActivity
+	for rows.Next() {
+		var a model.UserDailyActivity
+		if err := rows.Scan(&a.Date, &a.FirstSeenAt, &a.LastSeenAt, &a.LoginCount); err != nil {
+			return nil, errors.Wrap(err, "[ListUserActivity] row scan failed")
+		}
+		results = append(results, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "[ListUserActivity] rows iteration error")
+	}
+	return results, nil
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"server/internal/db"
+	"server/internal/model"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type PostgresAdminRepository struct {
+	db *db.DB
+}
+
+func NewPostgresAdminRepository(dbConn *db.DB) *PostgresAdminRepository {
+	return &PostgresAdminRepository{db: dbConn}
+}
+
+// SetUserStatus 계정 상태 변경 (사유는 action.Reason 을 users.status_reason 에도 기록)
+func (r *PostgresAdminRepository) SetUserStatus(ctx context.Context, action *model.AdminAction, status string, suspendedUntil *time.Time) error {
+	return r.withAction(ctx, "[SetUserStatus]", action, func(tx pgx.Tx) error {
+		reason := action.Reason
+		if status == model.UserStatusActive {
+			reason = ""
+		}
+		// suspended_until 은 TIMESTAMP(UTC) 컬럼이고 pgx 는 오프셋을 버리고 벽시계 값만 저장하므로 UTC 로 변환
+		if suspendedUntil != nil {
+			utc := suspendedUntil.UTC()
+			suspendedUntil = &utc
+		}
+		tag, err := tx.Exec(ctx, `
+			UPDATE users
+			   SET status=$2,
+			       suspended_until=$3,
+			       status_reason=$4,
+			       updated_at=NOW()
+			 WHERE id=$1
+		`, action.TargetUserID, status, suspendedUntil, reason)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return errors.Wrapf(ErrNotFound, "no user found with ID=%d", action.TargetUserID)
+		}
+		return nil
+	})
+}
+
+func (r *PostgresAdminRepository) SetUserRole(ctx context.Context, action *model.AdminAction, role string) error {
+	return r.withAction(ctx, "[SetUserRole]", action, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, `
+			UPDATE users
+			   SET role=$2,
+			       updated_at=NOW()
+			 WHERE id=$1
+		`, action.TargetUserID, role)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return errors.Wrapf(ErrNotFound, "no user found with ID=%d", action.TargetUserID)
+		}
+		return nil
+	})
+}
+
+func (r *PostgresAdminRepository) CreateNote(ctx context.Context, action *model.AdminAction, note *model.AdminNote) error {
+	return r.withAction(ctx, "[CreateNote]", action, func(tx pgx.Tx) error {
+		if err := tx.QueryRow(ctx, `
+			INSERT INTO admin_user_notes (user_id, admin_id, note)
+			     VALUES ($1, $2, $3)
+			  RETURNING id, created_at
+		`, note.UserID, note.AdminID, note.Note).Scan(&note.ID, &note.CreatedAt); err != nil {
+			return err
+		}
+		action.Details = map[string]interface{}{"note_id": note.ID}
+		return nil
+	})
+}
+
+// withAction fn 으로 유저를 변경한 뒤 같은 트랜잭션에서 admin_actions 기록
+func (r *PostgresAdminRepository) withAction(ctx context.Context, tag string, action *model.AdminAction, fn func(tx pgx.Tx) error) error {
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, tag+" begin tx failed")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := fn(tx); err != nil {
+		return errors.Wrap(err, tag+" apply change failed")
+	}
+
+	details := action.Details
+	if details == nil {
+		details = map[string]interface{}{}
+	}
+	detailsJSON, err := json.Marshal(details)
+	if err != nil {
+		return errors.Wrap(err, tag+" marshal details failed")
+	}
+	if err := tx.QueryRow(ctx, `
+		INSERT INTO admin_actions (admin_id, target_user_id, action, reason, details)
+		     VALUES ($1, $2, $3, $4, $5::jsonb)
+		  RETURNING id, created_at
+	`, action.AdminID, action.TargetUserID, action.Action, action.Reason, string(detailsJSON)).
+		Scan(&action.ID, &action.CreatedAt); err != nil {
+		return errors.Wrap(err, tag+" insert admin action failed")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, tag+" commit failed")
+	}
+	return nil
+}
+
+func (r *PostgresAdminRepository) ListNotes(ctx context.Context, userID int) ([]model.AdminNote, error) {
+	rows, err := r.db.Pool.Query(ctx, `
+		SELECT id, user_id, admin_id, note, created_at
+		  FROM admin_user_notes
+		 WHERE user_id = $1
+		 ORDER BY created_at DESC, id DESC
+	`, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ListNotes] query failed")
+	}
+	defer rows.Close()
+
+	var results []model.AdminNote
+	for rows.Next() {
+		var n model.AdminNote
+		if err := rows.Scan(&n.ID, &n.UserID, &n.AdminID, &n.Note, &n.CreatedAt); err != nil {
+			return nil, errors.Wrap(err, "[ListNotes] row scan failed")
+		}
+		results = append(results, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "[ListNotes] rows iteration error")
+	}
+	return results, nil
+}
+
+func (r *PostgresAdminRepository) ListActions(ctx context.Context, targetUserID, limit int) ([]model.AdminAction, error) {
+	rows, err := r.db.Pool.Query(ctx, `
+		SELECT id, admin_id, target_user_id, action, reason, details, created_at
+		  FROM admin_actions
+		 WHERE target_user_id = $1
+		 ORDER BY created_at DESC, id DESC
+		 LIMIT $2
+	`, targetUserID, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ListActions] query failed")
+	}
+	defer rows.Close()
+
+	var results []model.AdminAction
+	for rows.Next() {
+		var a model.AdminAction
+		if err := rows.Scan(&a.ID, &a.AdminID, &a.TargetUserID, &a.Action, &a.Reason, &a.Details, &a.CreatedAt); err != nil {
+			return nil, errors.Wrap(err, "[ListActions] row scan failed")
+		}
+		results = append(results, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "[ListActions] rows iteration error")
+	}
+	return results, nil
+}
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"server/internal/db"
+	"server/internal/model"
+	"server/internal/pagination"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+type PostgresBlockRepository struct {
+	db *db.DB
+}
+
+func NewPostgresBlockRepository(dbConn *db.DB) *PostgresBlockRepository {
+	return &PostgresBlockRepository{db: dbConn}
+}
+
+// RestrictedUserSorts 차단/뮤트 목록 정렬 허용 컬럼
+var RestrictedUserSorts = pagination.Whitelist{
+	"created_at": {Column: "created_at", Kind: pagination.KindTime},
+}
+
+// RestrictedUserSortKey 페이지 마지막 항목의 정렬 값 (다음 페이지 커서 생성용)
+func RestrictedUserSortKey(ru model.RestrictedUser, sortName string) (interface{}, int) {
+	return ru.CreatedAt, ru.RelationID
+}
+
+func (r *PostgresBlockRepository) Block(ctx context.Context, blockerID, blockedID int) error {
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "[Block] begin tx failed")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO user_blocks (blocker_id, blocked_id)
+		     VALUES ($1, $2)
+		ON CONFLICT (blocker_id, blocked_id) DO NOTHING
+	`, blockerID, blockedID); err != nil {
+		return errors.Wrap(err, "[Block] insert block failed")
+	}
+	if _, err := tx.Exec(ctx, `
+		DELETE FROM follows
+		 WHERE (follower_id = $1 AND followee_id = $2)
+		    OR (follower_id = $2 AND followee_id = $1)
+	`, blockerID, blockedID); err != nil {
+		return errors.Wrap(err, "[Block] delete follows failed")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, "[Block] commit failed")
+	}
+	return nil
+}
+
+func (r *PostgresBlockRepository) Unblock(ctx context.Context, blockerID, blockedID int) error {
+	tag, err := r.db.Pool.Exec(ctx, `
+		DELETE FROM user_blocks
+		 WHERE blocker_id = $1
+		   AND blocked_id = $2
+	`, blockerID, blockedID)
+	if err != nil {
+		return errors.Wrap(err, "[Unblock] exec fail")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Wrapf(ErrNotFound, "[Unblock] blocker=%d blocked=%d", blockerID, blockedID)
+	}
+	return nil
+}
+
+func (r *PostgresBlockRepository) IsBlockedEither(ctx context.Context, userA, userB int) (bool, error) {
+	var blocked bool
+	err := r.db.Pool.QueryRow(ctx, `
+		SELECT NOT `+NotBlockedCondition("$2", 1)+`
+	`, userA, userB).Scan(&blocked)
+	if err != nil {
+		return false, errors.Wrap(err, "[IsBlockedEither] queryRow scan fail")
+	}
+	return blocked, nil
+}
+
+func (r *PostgresBlockRepository) ListBlocked(ctx context.Context, blockerID int, page pagination.Request) ([]model.RestrictedUser, error) {
+	return r.listRestricted(ctx, "[ListBlocked]", "user_blocks", "blocker_id", "blocked_id", blockerID, page)
+}
+
+func (r *PostgresBlockRepository) Mute(ctx context.Context, muterID, mutedID int) error {
+	_, err := r.db.Pool.Exec(ctx, `
+		INSERT INTO user_mutes (muter_id, muted_id)
+		     VALUES ($1, $2)
+		ON CONFLICT (muter_id, muted_id) DO NOTHING
+	`, muterID, mutedID)
+	if err != nil {
+		return errors.Wrap(err, "[Mute] exec fail")
+	}
+	return nil
+}
+
+func (r *PostgresBlockRepository) Unmute(ctx context.Context, muterID, mutedID int) error {
+	tag, err := r.db.Pool.Exec(ctx, `
+		DELETE FROM user_mutes
+		 WHERE muter_id = $1
+		   AND muted_id = $2
+	`, muterID, mutedID)
+	if err != nil {
+		return errors.Wrap(err, "[Unmute] exec fail")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Wrapf(ErrNotFound, "[Unmute] muter=%d muted=%d", muterID, mutedID)
+	}
+	return nil
+}
+
+func (r *PostgresBlockRepository) ListMuted(ctx context.Context, muterID int, page pagination.Request) ([]model.RestrictedUser, error) {
+	return r.listRestricted(ctx, "[ListMuted]", "user_mutes", "muter_id", "muted_id", muterID, page)
+}
+
+// listRestricted table 의 ownerColumn = ownerID 인 행의 targetColumn 유저 목록 (keyset 페이지네이션)
+func (r *PostgresBlockRepository) listRestricted(
+	ctx context.Context,
+	tag, table, ownerColumn, targetColumn string,
+	ownerID int,
+	page pagination.Request,
+) ([]model.RestrictedUser, error) {
+	args := []interface{}{ownerID}
+	where := ""
+	keyset, keysetArgs, err := page.KeysetCondition(len(args) + 1)
+	if err != nil {
+		return nil, err
+	}
+	if keyset != "" {
+		where = "WHERE " + keyset
+		args = append(args, keysetArgs...)
+	}
+	args = append(args, page.FetchLimit())
+
+	rows, err := r.db.Pool.Query(ctx, `
+		SELECT id, user_id, nickname, display_name, profile_image, created_at
+		  FROM (
+		        SELECT t.id, u.id AS user_id, u.nickname, u.display_name, u.profile_image, t.created_at
+		          FROM `+table+` t
+		          JOIN users u ON u.id = t.`+targetColumn+`
+		         WHERE t.`+ownerColumn+` = $1
+		       ) l
+		 `+where+`
+		 ORDER BY `+page.OrderBy()+`
+		 LIMIT $`+strconv.Itoa(len(args)),
+		args...)
+	if err != nil {
+		return nil, errors.Wrap(err, tag+" query failed")
+	}
+	defer rows.Close()
+
+	var results []model.RestrictedUser
+	for rows.Next() {
+		var ru model.RestrictedUser
+		if err := rows.Scan(&ru.RelationID, &ru.UserID, &ru.Nickname, &ru.DisplayName, &ru.ProfileImage, &ru.CreatedAt); err != nil {
+			return nil, errors.Wrap(err, tag+" row scan failed")
+		}
+		results = append(results, ru)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, tag+" rows iteration error")
+	}
+	return results, nil
+}
@@ -0,0 +1,188 @@
+package repository
+
+import (
+	"context"
+	"server/internal/db"
+	"server/internal/model"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type PostgresConsentRepository struct {
+	db *db.DB
+}
+
+func NewPostgresConsentRepository(dbConn *db.DB) *PostgresConsentRepository {
+	return &PostgresConsentRepository{db: dbConn}
+}
+
+// 문서 종류별로 시행일(effective_at)이 지난 가장 최신 버전
+const currentLegalDocumentsQuery = `
+	SELECT DISTINCT ON (doc_type)
+	       id, doc_type, version, title, content_url, mandatory, effective_at, created_at
+	  FROM legal_documents
+	 WHERE effective_at <= NOW()
+	 ORDER BY doc_type, effective_at DESC, id DESC`
+
+func (r *PostgresConsentRepository) ListCurrentDocuments(ctx context.Context) ([]model.LegalDocument, error) {
+	return r.listDocuments(ctx, "[ListCurrentDocuments]", currentLegalDocumentsQuery)
+}
+
+func (r *PostgresConsentRepository) ListPendingMandatory(ctx context.Context, userID int) ([]model.LegalDocument, error) {
+	return r.listDocuments(ctx, "[ListPendingMandatory]", `
+		SELECT d.id, d.doc_type, d.version, d.title, d.content_url, d.mandatory, d.effective_at, d.created_at
+		  FROM (`+currentLegalDocumentsQuery+`) d
+		 WHERE d.mandatory
+		   AND NOT EXISTS (
+		         SELECT 1
+		           FROM user_consents c
+		          WHERE c.user_id = $1
+		            AND c.document_id = d.id
+		       )
+		 ORDER BY d.doc_type
+	`, userID)
+}
+
+func (r *PostgresConsentRepository) listDocuments(ctx context.Context, tag, query string, args ...interface{}) ([]model.LegalDocument, error) {
+	rows, err := r.db.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, tag+" query failed")
+	}
+	defer rows.Close()
+
+	var results []model.LegalDocument
+	for rows.Next() {
+		var d model.LegalDocument
+		if err := rows.Scan(&d.ID, &d.DocType, &d.Version, &d.Title, &d.ContentURL,
+			&d.Mandatory, &d.EffectiveAt, &d.CreatedAt); err != nil {
+			return nil, errors.Wrap(err, tag+" row scan failed")
+		}
+		results = append(results, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, tag+" rows iteration error")
+	}
+	return results, nil
+}
+
+// AcceptDocuments 여러 문서 동의를 한 트랜잭션으로 기록 (이미 동의한 문서는 무시)
+func (r *PostgresConsentRepository) AcceptDocuments(ctx context.Context, userID int, documentIDs []int, ipAddress, userAgent string) error {
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "[AcceptDocuments] begin tx failed")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	for _, docID := range documentIDs {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO user_consents (user_id, document_id, ip_address, user_agent)
+			     VALUES ($1, $2, $3, $4)
+			ON CONFLICT (user_id, document_id) DO NOTHING
+		`, userID, docID, ipAddress, userAgent); err != nil {
+			return errors.Wrapf(err, "[AcceptDocuments] insert consent failed (documentID=%d)", docID)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, "[AcceptDocuments] commit failed")
+	}
+	return nil
+}
+
+func (r *PostgresConsentRepository) ListUserConsents(ctx context.Context, userID int) ([]model.UserConsent, error) {
+	rows, err := r.db.Pool.Query(ctx, `
+		SELECT c.id, c.user_id, c.document_id, d.doc_type, d.version, c.agreed_at,
+		       COALESCE(c.ip_address, ''), COALESCE(c.user_agent, '')
+		  FROM user_consents c
+		  JOIN legal_documents d ON d.id = c.document_id
+		 WHERE c.user_id = $1
+		 ORDER BY c.agreed_at DESC, c.id DESC
+	`, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ListUserConsents] query failed")
+	}
+	defer rows.Close()
+
+	var results []model.UserConsent
+	for rows.Next() {
+		var c model.UserConsent
+		if err := rows.Scan(&c.ID, &c.UserID, &c.DocumentID, &c.DocType, &c.Version, &c.AgreedAt,
+			&c.IPAddress, &c.UserAgent); err != nil {
+			return nil, errors.Wrap(err, "[ListUserConsents] row scan failed")
+		}
+		results = append(results, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "[ListUserConsents] rows iteration error")
+	}
+	return results, nil
+}
+
+func (r *PostgresConsentRepository) RecordMarketingConsent(ctx context.Context, consent *model.MarketingConsent) error {
+	row := r.db.Pool.QueryRow(ctx, `
+		INSERT INTO marketing_consents (user_id, document_id, agreed, ip_address, user_agent)
+		     VALUES ($1, $2, $3, $4, $5)
+		  RETURNING id, created_at
+	`, consent.UserID, consent.DocumentID, consent.Agreed, consent.IPAddress, consent.UserAgent)
+
+	if err := row.Scan(&consent.ID, &consent.CreatedAt); err != nil {
+		return errors.Wrap(err, "[RecordMarketingConsent] insert scan fail")
+	}
+	return nil
+}
+
+const marketingConsentColumns = `id, user_id, document_id, agreed,
+       COALESCE(ip_address, ''), COALESCE(user_agent, ''), created_at`
+
+func scanMarketingConsent(row pgx.Row) (*model.MarketingConsent, error) {
+	var m model.MarketingConsent
+	if err := row.Scan(&m.ID, &m.UserID, &m.DocumentID, &m.Agreed, &m.IPAddress, &m.UserAgent, &m.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (r *PostgresConsentRepository) FindLatestMarketingConsent(ctx context.Context, userID int) (*model.MarketingConsent, error) {
+	row := r.db.Pool.QueryRow(ctx, `
+		SELECT `+marketingConsentColumns+`
+		  FROM marketing_consents
+		 WHERE user_id = $1
+		 ORDER BY created_at DESC, id DESC
+		 LIMIT 1
+	`, userID)
+
+	m, err := scanMarketingConsent(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "[FindLatestMarketingConsent] queryRow scan fail")
+	}
+	return m, nil
+}
+
+func (r *PostgresConsentRepository) ListMarketingConsents(ctx context.Context, userID int) ([]model.MarketingConsent, error) {
+	rows, err := r.db.Pool.Query(ctx, `
+		SELECT `+marketingConsentColumns+`
+		  FROM marketing_consents
+		 WHERE user_id = $1
+		 ORDER BY created_at DESC, id DESC
+	`, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ListMarketingConsents] query failed")
+	}
+	defer rows.Close()
+
+	var results []model.MarketingConsent
+	for rows.Next() {
+		m, err := scanMarketingConsent(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "[ListMarketingConsents] row scan failed")
+		}
+		results = append(results, *m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "[ListMarketingConsents] rows iteration error")
+	}
+	return results, nil
+}
@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"server/internal/db"
+	"server/internal/model"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type PostgresDataExportRepository struct {
+	db *db.DB
+}
+
+func NewPostgresDataExportRepository(dbConn *db.DB) *PostgresDataExportRepository {
+	return &PostgresDataExportRepository{db: dbConn}
+}
+
+const dataExportColumns = `id, user_id, status, COALESCE(file_key, ''), COALESCE(file_size, 0),
+       COALESCE(error_message, ''), expires_at, created_at, completed_at`
+
+func scanDataExport(row pgx.Row) (*model.DataExport, error) {
+	var e model.DataExport
+	if err := row.Scan(&e.ID, &e.UserID, &e.Status, &e.FileKey, &e.FileSize,
+		&e.ErrorMessage, &e.ExpiresAt, &e.CreatedAt, &e.CompletedAt); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func (r *PostgresDataExportRepository) Create(ctx context.Context, userID int) (*model.DataExport, error) {
+	row := r.db.Pool.QueryRow(ctx, `
+		INSERT INTO data_exports (user_id, status)
+		     VALUES ($1, $2)
+		  RETURNING `+dataExportColumns,
+		userID, model.DataExportStatusPending)
+
+	e, err := scanDataExport(row)
+	if err != nil {
+		return nil, errors.Wrap(err, "[Create] insert scan fail")
+	}
+	return e, nil
+}
+
+func (r *PostgresDataExportRepository) FindByID(ctx context.Context, id int) (*model.DataExport, error) {
+	row := r.db.Pool.QueryRow(ctx, `
+		SELECT `+dataExportColumns+`
+		  FROM data_exports
+		 WHERE id = $1
+	`, id)
+
+	e, err := scanDataExport(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.Wrapf(ErrNotFound, "[FindByID] no data export found with ID=%d", id)
+	} else if err != nil {
+		return nil, errors.Wrap(err, "[FindByID] queryRow scan fail")
+	}
+	return e, nil
+}
+
+// FindInProgressByUserID 대기/진행 중인 요청 (없으면 nil, nil)
+func (r *PostgresDataExportRepository) FindInProgressByUserID(ctx context.Context, userID int) (*model.DataExport, error) {
+	row := r.db.Pool.QueryRow(ctx, `
+		SELECT `+dataExportColumns+`
+		  FROM data_exports
+		 WHERE user_id = $1
+		   AND status IN ($2, $3)
+		 ORDER BY id DESC
+		 LIMIT 1
+	`, userID, model.DataExportStatusPending, model.DataExportStatusRunning)
+
+	e, err := scanDataExport(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "[FindInProgressByUserID] queryRow scan fail")
+	}
+	return e, nil
+}
+
+// ListResumable lease_until 이 NULL 인 RUNNING 은 점유 기한 도입 전에 중단된 요청
+func (r *PostgresDataExportRepository) ListResumable(ctx context.Context, limit int) ([]model.DataExport, error) {
+	return r.list(ctx, "[ListResumable]", `
+		SELECT `+dataExportColumns+`
+		  FROM data_exports
+		 WHERE status = $1
+		    OR (status = $2 AND (lease_until IS NULL OR lease_until < NOW()))
+		 ORDER BY id
+		 LIMIT $3
+	`, model.DataExportStatusPending, model.DataExportStatusRunning, limit)
+}
+
+// ListExpired 다운로드 기한이 지난 READY 요청 (파일 정리 대상)
+func (r *PostgresDataExportRepository) ListExpired(ctx context.Context, now time.Time, limit int) ([]model.DataExport, error) {
+	return r.list(ctx, "[ListExpired]", `
+		SELECT `+dataExportColumns+`
+		  FROM data_exports
+		 WHERE status = $1
+		   AND expires_at <= $2
+		 ORDER BY expires_at
+		 LIMIT $3
+	`, model.DataExportStatusReady, now, limit)
+}
+
+func (r *PostgresDataExportRepository) list(ctx context.Context, tag, query string, args ...interface{}) ([]model.DataExport, error) {
+	rows, err := r.db.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, tag+" query failed")
+	}
+	defer rows.Close()
+
+	var results []model.DataExport
+	for rows.Next() {
+		e, err := scanDataExport(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, tag+" row scan failed")
+		}
+		results = append(results, *e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, tag+" rows iteration error")
+	}
+	return results, nil
+}
+
+// Claim 조건부 UPDATE 한 번으로 선점하므로 같은 요청을 여러 인스턴스가 큐에 넣어도 한 곳만 생성
+func (r *PostgresDataExportRepository) Claim(ctx context.Context, id int, lease time.Duration) (bool, error) {
+	tag, err := r.db.Pool.Exec(ctx, `
+		UPDATE data_exports
+		   SET status=$2,
+		       lease_until=NOW() + make_interval(secs => $4)
+		 WHERE id=$1
+		   AND (status=$3 OR (status=$2 AND (lease_until IS NULL OR lease_until < NOW())))
+	`, id, model.DataExportStatusRunning, model.DataExportStatusPending, lease.Seconds())
+	if err != nil {
+		return false, errors.Wrap(err, "[Claim] exec fail")
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+func (r *PostgresDataExportRepository) MarkReady(ctx context.Context, id int, fileKey string, fileSize int64, expiresAt time.Time) error {
+	_, err := r.db.Pool.Exec(ctx, `
+		UPDATE data_exports
+		   SET status=$2,
+		       file_key=$3,
+		       file_size=$4,
+		       expires_at=$5,
+		       completed_at=NOW()
+		 WHERE id=$1
+	`, id, model.DataExportStatusReady, fileKey, fileSize, expiresAt)
+	if err != nil {
+		return errors.Wrap(err, "[MarkReady] exec fail")
+	}
+	return nil
+}
+
+func (r *PostgresDataExportRepository) MarkFailed(ctx context.Context, id int, errMsg string) error {
+	_, err := r.db.Pool.Exec(ctx, `
+		UPDATE data_exports
+		   SET status=$2,
+		       error_message=$3,
+		       completed_at=NOW()
+		 WHERE id=$1
+	`, id, model.DataExportStatusFailed, errMsg)
+	if err != nil {
+		return errors.Wrap(err, "[MarkFailed] exec fail")
+	}
+	return nil
+}
+
+func (r *PostgresDataExportRepository) MarkExpired(ctx context.Context, id int) error {
+	_, err := r.db.Pool.Exec(ctx, `
+		UPDATE data_exports
+		   SET status=$2,
+		       file_key=NULL
+		 WHERE id=$1
+	`, id, model.DataExportStatusExpired)
+	if err != nil {
+		return errors.Wrap(err, "[MarkExpired] exec fail")
+	}
+	return nil
+}
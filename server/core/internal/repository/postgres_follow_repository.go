@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"context"
+	"server/internal/db"
+	"server/internal/model"
+	"server/internal/pagination"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type PostgresFollowRepository struct {
+	db *db.DB
+}
+
+func NewPostgresFollowRepository(dbConn *db.DB) *PostgresFollowRepository {
+	return &PostgresFollowRepository{db: dbConn}
+}
+
+// FollowSorts 팔로워/팔로잉 목록 정렬 허용 컬럼 (팔로우한 시각 기준)
+var FollowSorts = pagination.Whitelist{
+	"created_at": {Column: "created_at", Kind: pagination.KindTime},
+}
+
+// FollowSortKey 페이지 마지막 항목의 정렬 값 (다음 페이지 커서 생성용)
+func FollowSortKey(fu model.FollowUser, sortName string) (interface{}, int) {
+	return fu.FollowedAt, fu.FollowID
+}
+
+const followColumns = `id, follower_id, followee_id, status, created_at, accepted_at`
+
+func scanFollow(row pgx.Row) (*model.Follow, error) {
+	var f model.Follow
+	if err := row.Scan(&f.ID, &f.FollowerID, &f.FolloweeID, &f.Status, &f.CreatedAt, &f.AcceptedAt); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+func (r *PostgresFollowRepository) Create(ctx context.Context, followerID, followeeID int, status string) (*model.Follow, error) {
+	row := r.db.Pool.QueryRow(ctx, `
+		INSERT INTO follows (follower_id, followee_id, status, accepted_at)
+		     VALUES ($1, $2, $3, CASE WHEN $3 = '`+model.FollowStatusAccepted+`' THEN NOW() END)
+		ON CONFLICT (follower_id, followee_id) DO NOTHING
+		  RETURNING `+followColumns,
+		followerID, followeeID, status)
+
+	f, err := scanFollow(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// 이미 팔로우 중이거나 요청을 보낸 상태 → 기존 관계 유지
+		return r.Find(ctx, followerID, followeeID)
+	} else if err != nil {
+		return nil, errors.Wrap(err, "[FollowRepository.Create] insert scan fail")
+	}
+	return f, nil
+}
+
+func (r *PostgresFollowRepository) Find(ctx context.Context, followerID, followeeID int) (*model.Follow, error) {
+	row := r.db.Pool.QueryRow(ctx, `
+		SELECT `+followColumns+`
+		  FROM follows
+		 WHERE follower_id = $1
+		   AND followee_id = $2
+	`, followerID, followeeID)
+
+	f, err := scanFollow(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.Wrapf(ErrNotFound, "[FollowRepository.Find] follower=%d followee=%d", followerID, followeeID)
+	} else if err != nil {
+		return nil, errors.Wrap(err, "[FollowRepository.Find] queryRow scan fail")
+	}
+	return f, nil
+}
+
+func (r *PostgresFollowRepository) Delete(ctx context.Context, followerID, followeeID int) error {
+	tag, err := r.db.Pool.Exec(ctx, `
+		DELETE FROM follows
+		 WHERE follower_id = $1
+		   AND followee_id = $2
+	`, followerID, followeeID)
+	if err != nil {
+		return errors.Wrap(err, "[FollowRepository.Delete] exec fail")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Wrapf(ErrNotFound, "[FollowRepository.Delete] follower=%d followee=%d", followerID, followeeID)
+	}
+	return nil
+}
+
+func (r *PostgresFollowRepository) Accept(ctx context.Context, followerID, followeeID int) (*model.Follow, error) {
+	row := r.db.Pool.QueryRow(ctx, `
+		UPDATE follows
+		   SET status = $3,
+		       accepted_at = NOW()
+		 WHERE follower_id = $1
+		   AND followee_id = $2
+		   AND status = $4
+		RETURNING `+followColumns,
+		followerID, followeeID, model.FollowStatusAccepted, model.FollowStatusPending)
+
+	f, err := scanFollow(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.Wrapf(ErrNotFound, "[FollowRepository.Accept] no pending request follower=%d followee=%d", followerID, followeeID)
+	} else if err != nil {
+		return nil, errors.Wrap(err, "[FollowRepository.Accept] update scan fail")
+	}
+	return f, nil
+}
+
+// ListFollowers userID 를 팔로우하는 유저 (status=PENDING 이면 받은 팔로우 요청)
+func (r *PostgresFollowRepository) ListFollowers(ctx context.Context, userID, viewerID int, status string, page pagination.Request) ([]model.FollowUser, error) {
+	return r.listFollowUsers(ctx, "[ListFollowers]", "follower_id", "followee_id", userID, viewerID, status, page)
+}
+
+// ListFollowing userID 가 팔로우하는 유저 (승인된 관계만)
+func (r *PostgresFollowRepository) ListFollowing(ctx context.Context, userID, viewerID int, page pagination.Request) ([]model.FollowUser, error) {
+	return r.listFollowUsers(ctx, "[ListFollowing]", "followee_id", "follower_id", userID, viewerID, model.FollowStatusAccepted, page)
+}
+
+// listFollowUsers otherColumn 쪽 유저 프로필을 목록으로 반환
+// keyset 조건의 id/created_at 이 follows 컬럼을 가리키도록 서브쿼리로 감싼 뒤 페이지네이션
+func (r *PostgresFollowRepository) listFollowUsers(
+	ctx context.Context,
+	tag, otherColumn, selfColumn string,
+	userID, viewerID int,
+	status string,
+	page pagination.Request,
+) ([]model.FollowUser, error) {
+	args := []interface{}{userID, status, viewerID}
+	where := ""
+	keyset, keysetArgs, err := page.KeysetCondition(len(args) + 1)
+	if err != nil {
+		return nil, err
+	}
+	if keyset != "" {
+		where = "WHERE " + keyset
+		args = append(args, keysetArgs...)
+	}
+	args = append(args, page.FetchLimit())
+
+	rows, err := r.db.Pool.Query(ctx, `
+		SELECT id, user_id, nickname, display_name, profile_image, created_at
+		  FROM (
+		        SELECT f.id, u.id AS user_id, u.nickname, u.display_name, u.profile_image, f.created_at
+		          FROM follows f
+		          JOIN users u ON u.id = f.`+otherColumn+`
+		         WHERE f.`+selfColumn+` = $1
+		           AND f.status = $2
+		           AND u.deleted_at IS NULL
+		           AND `+NotBlockedCondition("u.id", 3)+`
+		       ) l
+		 `+where+`
+		 ORDER BY `+page.OrderBy()+`
+		 LIMIT $`+strconv.Itoa(len(args)),
+		args...)
+	if err != nil {
+		return nil, errors.Wrap(err, tag+" query failed")
+	}
+	defer rows.Close()
+
+	var results []model.FollowUser
+	for rows.Next() {
+		var fu model.FollowUser
+		if err := rows.Scan(&fu.FollowID, &fu.UserID, &fu.Nickname, &fu.DisplayName, &fu.ProfileImage, &fu.FollowedAt); err != nil {
+			return nil, errors.Wrap(err, tag+" row scan failed")
+		}
+		results = append(results, fu)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, tag+" rows iteration error")
+	}
+	return results, nil
+}
+
+// Counts 승인된 팔로워/팔로잉 수 (탈퇴 유저 제외, 인덱스 범위 조회)
+func (r *PostgresFollowRepository) Counts(ctx context.Context, userID int) (*model.FollowCounts, error) {
+	var c model.FollowCounts
+	err := r.db.Pool.QueryRow(ctx, `
+		SELECT (SELECT COUNT(*)
+		          FROM follows f
+		          JOIN users u ON u.id = f.follower_id
+		         WHERE f.followee_id = $1
+		           AND f.status = $2
+		           AND u.deleted_at IS NULL),
+		       (SELECT COUNT(*)
+		          FROM follows f
+		          JOIN users u ON u.id = f.followee_id
+		         WHERE f.follower_id = $1
+		           AND f.status = $2
+		           AND u.deleted_at IS NULL)
+	`, userID, model.FollowStatusAccepted).Scan(&c.Followers, &c.Following)
+	if err != nil {
+		return nil, errors.Wrap(err, "[FollowRepository.Counts] queryRow scan fail")
+	}
+	return &c, nil
+}
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"server/internal/db"
+	"server/internal/model"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+)
+
+// Postgres unique_violation
+const pgUniqueViolation = "23505"
+
+type PostgresHandleRepository struct {
+	db *db.DB
+}
+
+func NewPostgresHandleRepository(dbConn *db.DB) *PostgresHandleRepository {
+	return &PostgresHandleRepository{db: dbConn}
+}
+
+func (r *PostgresHandleRepository) FindUserByHandle(ctx context.Context, handle string) (*model.User, error) {
+	row := r.db.Pool.QueryRow(ctx, `
+		SELECT `+userColumns+`
+		  FROM users
+		 WHERE handle = $1
+	`, handle)
+
+	u, err := scanUser(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.Wrapf(ErrNotFound, "[FindUserByHandle] no user found with handle=%s", handle)
+	} else if err != nil {
+		return nil, errors.Wrap(err, "[FindUserByHandle] queryRow scan fail")
+	}
+	return u, nil
+}
+
+func (r *PostgresHandleRepository) FindCurrentHandle(ctx context.Context, oldHandle string, reservation time.Duration) (string, error) {
+	var handle string
+	err := r.db.Pool.QueryRow(ctx, `
+		SELECT u.handle
+		  FROM user_handle_history h
+		  JOIN users u ON u.id = h.user_id
+		 WHERE h.handle = $1
+		   AND h.changed_at > NOW() - make_interval(secs => $2)
+	`, oldHandle, reservation.Seconds()).Scan(&handle)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", errors.Wrapf(ErrNotFound, "[FindCurrentHandle] no history for handle=%s", oldHandle)
+	} else if err != nil {
+		return "", errors.Wrap(err, "[FindCurrentHandle] queryRow scan fail")
+	}
+	return handle, nil
+}
+
+func (r *PostgresHandleRepository) ChangeHandle(ctx context.Context, userID int, handle string, policy model.HandlePolicy) (*model.User, error) {
+	tx, err := r.db.Pool.Begin(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ChangeHandle] begin tx failed")
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var current string
+	err = tx.QueryRow(ctx, `SELECT handle FROM users WHERE id = $1 FOR UPDATE`, userID).Scan(&current)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.Wrapf(ErrNotFound, "[ChangeHandle] no user found with ID=%d", userID)
+	} else if err != nil {
+		return nil, errors.Wrap(err, "[ChangeHandle] lock user failed")
+	}
+
+	if current != handle {
+		// 유저 행을 잠근 상태에서 확인하므로 동시에 보낸 변경 요청도 주기 제한을 피하지 못함
+		var recentlyChanged bool
+		if err := tx.QueryRow(ctx, `
+			SELECT EXISTS (
+				SELECT 1
+				  FROM user_handle_history
+				 WHERE user_id = $1
+				   AND changed_at > NOW() - make_interval(secs => $2)
+			)
+		`, userID, policy.Cooldown.Seconds()).Scan(&recentlyChanged); err != nil {
+			return nil, errors.Wrap(err, "[ChangeHandle] check cooldown failed")
+		}
+		if recentlyChanged {
+			return nil, errors.Wrapf(ErrTooSoon, "[ChangeHandle] user %d changed handle within %s", userID, policy.Cooldown)
+		}
+
+		// 본인이 예전에 쓰던 handle 이나 예약 기간이 지난 다른 유저의 예전 handle 은 이력에서 빼고 사용
+		var ownerID int
+		var reserved bool
+		err = tx.QueryRow(ctx, `
+			SELECT user_id, changed_at > NOW() - make_interval(secs => $2)
+			  FROM user_handle_history
+			 WHERE handle = $1
+		`, handle, policy.Reservation.Seconds()).Scan(&ownerID, &reserved)
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+		case err != nil:
+			return nil, errors.Wrap(err, "[ChangeHandle] check history failed")
+		case ownerID != userID && reserved:
+			return nil, errors.Wrapf(ErrConflict, "[ChangeHandle] handle %s is reserved by another user", handle)
+		default:
+			if _, err := tx.Exec(ctx, `DELETE FROM user_handle_history WHERE handle = $1`, handle); err != nil {
+				return nil, errors.Wrap(err, "[ChangeHandle] reclaim handle failed")
+			}
+		}
+
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO user_handle_history (user_id, handle)
+			     VALUES ($1, $2)
+			ON CONFLICT (handle) DO NOTHING
+		`, userID, current); err != nil {
+			return nil, errors.Wrap(err, "[ChangeHandle] insert history failed")
+		}
+		if _, err := tx.Exec(ctx, `
+			UPDATE users
+			   SET handle = $2,
+			       updated_at = NOW()
+			 WHERE id = $1
+		`, userID, handle); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+				return nil, errors.Wrapf(ErrConflict, "[ChangeHandle] handle %s is taken", handle)
+			}
+			return nil, errors.Wrap(err, "[ChangeHandle] update handle failed")
+		}
+	}
+
+	u, err := scanUser(tx.QueryRow(ctx, `SELECT `+userColumns+` FROM users WHERE id = $1`, userID))
+	if err != nil {
+		return nil, errors.Wrap(err, "[ChangeHandle] reload user failed")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errors.Wrap(err, "[ChangeHandle] commit failed")
+	}
+	return u, nil
+}
+
+func (r *PostgresHandleRepository) ListHistory(ctx context.Context, userID int) ([]model.HandleChange, error) {
+	rows, err := r.db.Pool.Query(ctx, `
+		SELECT handle, changed_at
+		  FROM user_handle_history
+		 WHERE user_id = $1
+		 ORDER BY changed_at DESC, id DESC
+	`, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ListHistory] query failed")
+	}
+	defer rows.Close()
+
+	var results []model.HandleChange
+	for rows.Next() {
+		var h model.HandleChange
+		if err := rows.Scan(&h.Handle, &h.ChangedAt); err != nil {
+			return nil, errors.Wrap(err, "[ListHistory] row scan failed")
+		}
+		results = append(results, h)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "[ListHistory] rows iteration error")
+	}
+	return results, nil
+}
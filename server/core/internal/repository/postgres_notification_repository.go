@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"server/internal/db"
+	"server/internal/model"
+	"server/internal/pagination"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type PostgresNotificationRepository struct {
+	db *db.DB
+}
+
+func NewPostgresNotificationRepository(dbConn *db.DB) *PostgresNotificationRepository {
+	return &PostgresNotificationRepository{db: dbConn}
+}
+
+// NotificationSorts 알림 목록 정렬 허용 컬럼 (묶인 알림은 마지막 갱신 시각 기준)
+var NotificationSorts = pagination.Whitelist{
+	"updated_at": {Column: "updated_at", Kind: pagination.KindTime},
+}
+
+// NotificationSortKey 페이지 마지막 항목의 정렬 값 (다음 페이지 커서 생성용)
+func NotificationSortKey(n model.Notification, sortName string) (interface{}, int) {
+	return n.UpdatedAt, n.ID
+}
+
+const notificationColumns = `id, user_id, type, actor_id, payload, group_key, group_count, read_at, created_at, updated_at`
+
+func scanNotification(row pgx.Row) (*model.Notification, error) {
+	var n model.Notification
+	if err := row.Scan(&n.ID, &n.UserID, &n.Type, &n.ActorID, &n.Payload, &n.GroupKey, &n.GroupCount,
+		&n.ReadAt, &n.CreatedAt, &n.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+func (r *PostgresNotificationRepository) Create(ctx context.Context, n *model.Notification) (*model.Notification, error) {
+	payload := n.Payload
+	if payload == nil {
+		payload = map[string]interface{}{}
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "[NotificationRepository.Create] marshal payload failed")
+	}
+
+	// group_key 가 빈 행은 부분 유니크 인덱스 대상이 아니므로 항상 새 행으로 들어감
+	row := r.db.Pool.QueryRow(ctx, `
+		INSERT INTO notifications (user_id, type, actor_id, payload, group_key)
+		     VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, group_key) WHERE read_at IS NULL AND group_key <> ''
+		DO UPDATE SET actor_id = EXCLUDED.actor_id,
+		              payload = EXCLUDED.payload,
+		              group_count = notifications.group_count + 1,
+		              updated_at = NOW()
+		  RETURNING `+notificationColumns,
+		n.UserID, n.Type, n.ActorID, string(payloadJSON), n.GroupKey)
+
+	created, err := scanNotification(row)
+	if err != nil {
+		return nil, errors.Wrap(err, "[NotificationRepository.Create] insert scan fail")
+	}
+	return created, nil
+}
+
+func (r *PostgresNotificationRepository) List(ctx context.Context, userID int, unreadOnly bool, page pagination.Request) ([]model.Notification, error) {
+	conds := "user_id = $1"
+	args := []interface{}{userID}
+	if unreadOnly {
+		conds += " AND read_at IS NULL"
+	}
+	keyset, keysetArgs, err := page.KeysetCondition(len(args) + 1)
+	if err != nil {
+		return nil, err
+	}
+	if keyset != "" {
+		conds += " AND " + keyset
+		args = append(args, keysetArgs...)
+	}
+	args = append(args, page.FetchLimit())
+
+	rows, err := r.db.Pool.Query(ctx, `
+		SELECT `+notificationColumns+`
+		  FROM notifications
+		 WHERE `+conds+`
+		 ORDER BY `+page.OrderBy()+`
+		 LIMIT $`+strconv.Itoa(len(args)),
+		args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "[NotificationRepository.List] query failed")
+	}
+	defer rows.Close()
+
+	var results []model.Notification
+	for rows.Next() {
+		n, err := scanNotification(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "[NotificationRepository.List] row scan failed")
+		}
+		results = append(results, *n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "[NotificationRepository.List] rows iteration error")
+	}
+	return results, nil
+}
+
+func (r *PostgresNotificationRepository) CountUnread(ctx context.Context, userID int) (int, error) {
+	var count int
+	err := r.db.Pool.QueryRow(ctx, `
+		SELECT COUNT(*)
+		  FROM notifications
+		 WHERE user_id = $1
+		   AND read_at IS NULL
+	`, userID).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "[CountUnread] queryRow scan fail")
+	}
+	return count, nil
+}
+
+// MarkRead 이미 읽은 알림이면 그대로 성공
+func (r *PostgresNotificationRepository) MarkRead(ctx context.Context, userID, id int) error {
+	tag, err := r.db.Pool.Exec(ctx, `
+		UPDATE notifications
+		   SET read_at = COALESCE(read_at, NOW())
+		 WHERE id = $1
+		   AND user_id = $2
+	`, id, userID)
+	if err != nil {
+		return errors.Wrap(err, "[MarkRead] exec fail")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Wrapf(ErrNotFound, "[MarkRead] notification %d of user %d", id, userID)
+	}
+	return nil
+}
+
+func (r *PostgresNotificationRepository) MarkAllRead(ctx context.Context, userID int) (int64, error) {
+	tag, err := r.db.Pool.Exec(ctx, `
+		UPDATE notifications
+		   SET read_at = NOW()
+		 WHERE user_id = $1
+		   AND read_at IS NULL
+	`, userID)
+	if err != nil {
+		return 0, errors.Wrap(err, "[MarkAllRead] exec fail")
+	}
+	return tag.RowsAffected(), nil
+}
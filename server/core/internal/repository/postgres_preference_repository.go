@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"server/internal/db"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+type PostgresPreferenceRepository struct {
+	db *db.DB
+}
+
+func NewPostgresPreferenceRepository(dbConn *db.DB) *PostgresPreferenceRepository {
+	return &PostgresPreferenceRepository{db: dbConn}
+}
+
+func (r *PostgresPreferenceRepository) Get(ctx context.Context, userID int) (map[string]json.RawMessage, error) {
+	var prefs map[string]json.RawMessage
+	err := r.db.Pool.QueryRow(ctx, `
+		SELECT prefs
+		  FROM user_preferences
+		 WHERE user_id = $1
+	`, userID).Scan(&prefs)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return map[string]json.RawMessage{}, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "[PreferenceRepository.Get] queryRow scan fail")
+	}
+	return prefs, nil
+}
+
+// Merge 읽고-수정-쓰기 대신 JSONB 연산자(||, -)로 한 번에 갱신하여 동시 요청이 서로의 키를 덮어쓰지 않음
+func (r *PostgresPreferenceRepository) Merge(ctx context.Context, userID int, set map[string]interface{}, unset []string) (map[string]json.RawMessage, error) {
+	setJSON, err := json.Marshal(set)
+	if err != nil {
+		return nil, errors.Wrap(err, "[PreferenceRepository.Merge] marshal fail")
+	}
+	if unset == nil {
+		unset = []string{}
+	}
+
+	var prefs map[string]json.RawMessage
+	err = r.db.Pool.QueryRow(ctx, `
+		INSERT INTO user_preferences (user_id, prefs)
+		     VALUES ($1, $2::jsonb - $3::text[])
+		ON CONFLICT (user_id) DO UPDATE
+		        SET prefs = (user_preferences.prefs || $2::jsonb) - $3::text[],
+		            updated_at = NOW()
+		  RETURNING prefs
+	`, userID, string(setJSON), unset).Scan(&prefs)
+	if err != nil {
+		return nil, errors.Wrap(err, "[PreferenceRepository.Merge] upsert scan fail")
+	}
+	return prefs, nil
+}
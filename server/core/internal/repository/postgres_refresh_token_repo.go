@@ -2,7 +2,7 @@ package repository
 
 import (
 	"context"
-	"database/sql"
+	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
 	"server/internal/db"
 	"server/internal/model"
@@ -16,17 +16,18 @@ func NewPostgresRefreshTokenRepo(dbConn *db.DB) *PostgresRefreshTokenRepo {
 	return &PostgresRefreshTokenRepo{db: dbConn}
 }
 
-// CreateOrUpdate 토큰이 이미 존재하면 업데이트하고, 없으면 새로 생성
+// CreateOrUpdate 토큰이 이미 존재하면 업데이트하고, 없으면 새로 생성 (rt.ID, rt.CreatedAt 채워짐)
 func (r *PostgresRefreshTokenRepo) CreateOrUpdate(ctx context.Context, rt *model.RefreshToken) error {
-	_, err := r.db.Pool.Exec(ctx,
+	row := r.db.Pool.QueryRow(ctx,
 		`INSERT INTO refresh_tokens (user_id, token, expired_at)
 		 VALUES ($1, $2, $3)
 		 ON CONFLICT (token) DO UPDATE
 		   SET user_id = EXCLUDED.user_id,
 		       expired_at = EXCLUDED.expired_at
+		 RETURNING id, created_at
 		`,
 		rt.UserID, rt.Token, rt.ExpiredAt)
-	if err != nil {
+	if err := row.Scan(&rt.ID, &rt.CreatedAt); err != nil {
 		return errors.Wrap(err, "[CreateOrUpdate] insert/merge failed")
 	}
 	return nil
@@ -42,8 +43,8 @@ func (r *PostgresRefreshTokenRepo) FindByToken(ctx context.Context, token string
 	)
 	var rt model.RefreshToken
 	if err := row.Scan(&rt.ID, &rt.UserID, &rt.Token, &rt.ExpiredAt, &rt.CreatedAt); err != nil {
-		if err == sql.ErrNoRows {
-			return nil, errors.New("[FindByToken] token not found")
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.Wrap(ErrNotFound, "[FindByToken] token not found")
 		}
 		return nil, errors.Wrap(err, "[FindByToken] query fail")
 	}
@@ -61,3 +62,43 @@ func (r *PostgresRefreshTokenRepo) DeleteByToken(ctx context.Context, token stri
 	}
 	return nil
 }
+
+// DeleteAllByUserID 해당 유저의 모든 세션(refresh token)을 삭제
+func (r *PostgresRefreshTokenRepo) DeleteAllByUserID(ctx context.Context, userID int) error {
+	_, err := r.db.Pool.Exec(ctx,
+		`DELETE FROM refresh_tokens WHERE user_id = $1`,
+		userID,
+	)
+	if err != nil {
+		return errors.Wrap(err, "[DeleteAllByUserID] exec fail")
+	}
+	return nil
+}
+
+// ListByUserID 해당 유저의 세션(refresh token) 목록
+func (r *PostgresRefreshTokenRepo) ListByUserID(ctx context.Context, userID int) ([]model.RefreshToken, error) {
+	rows, err := r.db.Pool.Query(ctx,
+		`SELECT id, user_id, token, expired_at, created_at
+		   FROM refresh_tokens
+		  WHERE user_id = $1
+		  ORDER BY id`,
+		userID,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ListByUserID] query fail")
+	}
+	defer rows.Close()
+
+	var results []model.RefreshToken
+	for rows.Next() {
+		var rt model.RefreshToken
+		if err := rows.Scan(&rt.ID, &rt.UserID, &rt.Token, &rt.ExpiredAt, &rt.CreatedAt); err != nil {
+			return nil, errors.Wrap(err, "[ListByUserID] row scan fail")
+		}
+		results = append(results, rt)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "[ListByUserID] rows iteration error")
+	}
+	return results, nil
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"server/internal/db"
+	"server/internal/model"
+
+	"github.com/pkg/errors"
+)
+
+type PostgresUserIdentityRepository struct {
+	db *db.DB
+}
+
+func NewPostgresUserIdentityRepository(dbConn *db.DB) *PostgresUserIdentityRepository {
+	return &PostgresUserIdentityRepository{db: dbConn}
+}
+
+// Upsert (provider, provider_user_id) 기준으로 생성 또는 토큰 갱신 (identity.ID 채워짐)
+func (r *PostgresUserIdentityRepository) Upsert(ctx context.Context, identity *model.UserIdentity) error {
+	row := r.db.Pool.QueryRow(ctx, `
+		INSERT INTO user_identities (user_id, provider, provider_user_id, access_token, refresh_token)
+		     VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (provider, provider_user_id) DO UPDATE
+		        SET user_id = EXCLUDED.user_id,
+		            access_token = EXCLUDED.access_token,
+		            refresh_token = COALESCE(NULLIF(EXCLUDED.refresh_token, ''), user_identities.refresh_token),
+		            updated_at = NOW()
+		  RETURNING id, created_at, updated_at
+	`, identity.UserID, identity.Provider, identity.ProviderUserID, identity.AccessToken, identity.RefreshToken)
+
+	if err := row.Scan(&identity.ID, &identity.CreatedAt, &identity.UpdatedAt); err != nil {
+		return errors.Wrap(err, "[Upsert] insert/merge failed")
+	}
+	return nil
+}
+
+func (r *PostgresUserIdentityRepository) ListByUserID(ctx context.Context, userID int) ([]model.UserIdentity, error) {
+	rows, err := r.db.Pool.Query(ctx, `
+		SELECT id, user_id, provider, provider_user_id,
+		       COALESCE(access_token, ''), COALESCE(refresh_token, ''), created_at, updated_at
+		  FROM user_identities
+		 WHERE user_id = $1
+		 ORDER BY id
+	`, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ListByUserID] query failed")
+	}
+	defer rows.Close()
+
+	var results []model.UserIdentity
+	for rows.Next() {
+		var i model.UserIdentity
+		if err := rows.Scan(&i.ID, &i.UserID, &i.Provider, &i.ProviderUserID,
+			&i.AccessToken, &i.RefreshToken, &i.CreatedAt, &i.UpdatedAt); err != nil {
+			return nil, errors.Wrap(err, "[ListByUserID] row scan failed")
+		}
+		results = append(results, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "[ListByUserID] rows iteration error")
+	}
+	return results, nil
+}
+
+func (r *PostgresUserIdentityRepository) DeleteByUserID(ctx context.Context, userID int) error {
+	_, err := r.db.Pool.Exec(ctx, `DELETE FROM user_identities WHERE user_id = $1`, userID)
+	if err != nil {
+		return errors.Wrap(err, "[DeleteByUserID] exec fail")
+	}
+	return nil
+}
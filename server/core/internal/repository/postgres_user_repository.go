@@ -2,10 +2,16 @@ package repository
 
 import (
 	"context"
-	"database/sql"
+	"fmt"
 	"server/internal/db"
 	"server/internal/model"
+	"server/internal/pagination"
+	"server/pkg/hangul"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
 )
 
@@ -21,102 +27,429 @@ func (r *PostgresUserRepository) CreateUser(ctx context.Context, user *model.Use
 	row := r.db.Pool.QueryRow(ctx, `
 		INSERT INTO users (oauth_provider, email, name, nickname, profile_image, role, visits_count)
 		     VALUES ($1, $2, $3, $4, $5, $6, $7)
-		 RETURNING id, created_at, updated_at
+		 RETURNING id, handle, created_at, updated_at
 	`, user.OauthProvider, user.Email, user.Name, user.Nickname,
 		user.ProfileImage, user.Role, user.VisitsCount)
 
-	if err := row.Scan(&user.ID, &user.CreatedAt, &user.UpdatedAt); err != nil {
+	if err := row.Scan(&user.ID, &user.Handle, &user.CreatedAt, &user.UpdatedAt); err != nil {
 		return nil, errors.Wrap(err, "[CreateUser] insert scan fail")
 	}
 	return user, nil
 }
 
+// users 테이블 SELECT 컬럼 목록 (scanUser 의 Scan 순서와 일치해야 함)
+const userColumns = `id, oauth_provider, email, name, nickname, handle, profile_image, avatar_key,
+       display_name, bio,
+       role, status, suspended_until, status_reason, visits_count,
+       last_login_at, last_seen_at, onboarded_at, created_at, updated_at,
+       deleted_at, purge_after, anonymized_at`
+
+// scanUser pgx.Row / pgx.Rows 에서 userColumns 순서대로 model.User 로 스캔
+// extra 는 userColumns 뒤에 추가로 SELECT 한 컬럼 (검색 점수 등)
+func scanUser(row pgx.Row, extra ...interface{}) (*model.User, error) {
+	var u model.User
+	dest := []interface{}{&u.ID, &u.OauthProvider, &u.Email, &u.Name, &u.Nickname, &u.Handle,
+		&u.ProfileImage, &u.AvatarKey, &u.DisplayName, &u.Bio,
+		&u.Role, &u.Status, &u.SuspendedUntil, &u.StatusReason, &u.VisitsCount,
+		&u.LastLoginAt, &u.LastSeenAt, &u.OnboardedAt, &u.CreatedAt, &u.UpdatedAt,
+		&u.DeletedAt, &u.PurgeAfter, &u.AnonymizedAt}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
 func (r *PostgresUserRepository) FindByID(ctx context.Context, id int) (*model.User, error) {
 	row := r.db.Pool.QueryRow(ctx, `
-		SELECT id, oauth_provider, email, name, nickname, profile_image, 
-		       role, visits_count, created_at, updated_at
+		SELECT `+userColumns+`
 		  FROM users
 		 WHERE id = $1
 	`, id)
 
-	var u model.User
-	err := row.Scan(&u.ID, &u.OauthProvider, &u.Email, &u.Name, &u.Nickname,
-		&u.ProfileImage, &u.Role, &u.VisitsCount, &u.CreatedAt, &u.UpdatedAt)
-	if err == sql.ErrNoRows {
-		return nil, errors.Errorf("no user found with ID=%d", id)
+	u, err := scanUser(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.Wrapf(ErrNotFound, "[FindByID] no user found with ID=%d", id)
 	} else if err != nil {
 		return nil, errors.Wrap(err, "[FindByID] queryRow scan fail")
 	}
-	return &u, nil
+	return u, nil
 }
 
 func (r *PostgresUserRepository) FindByEmail(ctx context.Context, email string) (*model.User, error) {
 	row := r.db.Pool.QueryRow(ctx, `
-		SELECT id, oauth_provider, email, name, nickname, profile_image, role, visits_count, created_at, updated_at
+		SELECT `+userColumns+`
 		  FROM users
 		 WHERE email = $1
 	`, email)
 
-	var u model.User
-	err := row.Scan(&u.ID, &u.OauthProvider, &u.Email, &u.Name, &u.Nickname, &u.ProfileImage,
-		&u.Role, &u.VisitsCount, &u.CreatedAt, &u.UpdatedAt)
-	if err == sql.ErrNoRows {
+	u, err := scanUser(row)
+	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, err // 상위에서 err != nil 로 판단
 	} else if err != nil {
 		return nil, errors.Wrap(err, "[FindByEmail] queryRow scan fail")
 	}
-	return &u, nil
+	return u, nil
+}
+
+// UserSorts 유저 목록 정렬 허용 컬럼
+var UserSorts = pagination.Whitelist{
+	"id":           {Column: "id", Kind: pagination.KindInt},
+	"created_at":   {Column: "created_at", Kind: pagination.KindTime},
+	"nickname":     {Column: "nickname", Kind: pagination.KindString},
+	"visits_count": {Column: "visits_count", Kind: pagination.KindInt},
+}
+
+// UserSortKey 페이지 마지막 행의 정렬 값 (다음 페이지 커서 생성용)
+func UserSortKey(u model.User, sortName string) (interface{}, int) {
+	switch sortName {
+	case "created_at":
+		return u.CreatedAt, u.ID
+	case "nickname":
+		return u.Nickname, u.ID
+	case "visits_count":
+		return u.VisitsCount, u.ID
+	default:
+		return u.ID, u.ID
+	}
+}
+
+// ListUsers 필터 + keyset 페이지네이션 (탈퇴(soft delete)한 유저는 제외)
+// 다음 페이지 존재 여부 판단을 위해 page.FetchLimit() 건까지 반환
+func (r *PostgresUserRepository) ListUsers(ctx context.Context, filter model.UserFilter, page pagination.Request) ([]model.User, error) {
+	conds := []string{"deleted_at IS NULL"}
+	var args []interface{}
+	addCond := func(format string, value interface{}) {
+		args = append(args, value)
+		conds = append(conds, fmt.Sprintf(format, len(args)))
+	}
+	if filter.OauthProvider != "" {
+		addCond("oauth_provider = $%d", filter.OauthProvider)
+	}
+	if filter.Role != "" {
+		addCond("role = $%d", filter.Role)
+	}
+	if filter.CreatedFrom != nil {
+		addCond("created_at >= $%d", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		addCond("created_at < $%d", *filter.CreatedTo)
+	}
+	if filter.EmailPrefix != "" {
+		addCond(`lower(email) LIKE $%d || '%%'`, escapeLike(strings.ToLower(filter.EmailPrefix)))
+	}
+	if filter.NicknamePrefix != "" {
+		addCond(`nickname LIKE $%d || '%%'`, escapeLike(filter.NicknamePrefix))
+	}
+
+	keyset, keysetArgs, err := page.KeysetCondition(len(args) + 1)
+	if err != nil {
+		return nil, err
+	}
+	if keyset != "" {
+		conds = append(conds, keyset)
+		args = append(args, keysetArgs...)
+	}
+	args = append(args, page.FetchLimit())
+
+	rows, err := r.db.Pool.Query(ctx, `
+		SELECT `+userColumns+`
+		  FROM users
+		 WHERE `+strings.Join(conds, " AND ")+`
+		 ORDER BY `+page.OrderBy()+`
+		 LIMIT $`+strconv.Itoa(len(args)),
+		args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ListUsers] query failed")
+	}
+	defer rows.Close()
+
+	var results []model.User
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "[ListUsers] row scan failed")
+		}
+		results = append(results, *u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "[ListUsers] rows iteration error")
+	}
+	return results, nil
+}
+
+// escapeLike LIKE 패턴 특수문자(%, _, \)를 리터럴로 취급
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+// UpdateUser 전달된(nil 이 아닌) 필드만 갱신하고 갱신된 유저를 반환
+// upd.ExpectedUpdatedAt 이 있으면 그 사이 다른 수정이 있었을 때 ErrConflict
+func (r *PostgresUserRepository) UpdateUser(ctx context.Context, id int, upd model.UserUpdate) (*model.User, error) {
+	if upd.IsEmpty() {
+		return r.FindByID(ctx, id)
+	}
+
+	sets := make([]string, 0, 8)
+	args := []interface{}{id}
+	addSet := func(column string, value *string) {
+		if value == nil {
+			return
+		}
+		args = append(args, *value)
+		sets = append(sets, fmt.Sprintf("%s=$%d", column, len(args)))
+	}
+	addSet("name", upd.Name)
+	addSet("nickname", upd.Nickname)
+	addSet("display_name", upd.DisplayName)
+	addSet("bio", upd.Bio)
+	addSet("profile_image", upd.ProfileImage)
+	addSet("avatar_key", upd.AvatarKey)
+	addSet("role", upd.Role)
+	sets = append(sets, "updated_at=NOW()")
+
+	where := "id=$1"
+	if upd.ExpectedUpdatedAt != nil {
+		args = append(args, *upd.ExpectedUpdatedAt)
+		where += fmt.Sprintf(" AND updated_at=$%d", len(args))
+	}
+
+	row := r.db.Pool.QueryRow(ctx, `
+		UPDATE users
+		   SET `+strings.Join(sets, ", ")+`
+		 WHERE `+where+`
+		RETURNING `+userColumns,
+		args...)
+
+	u, err := scanUser(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		if upd.ExpectedUpdatedAt != nil {
+			if _, findErr := r.FindByID(ctx, id); findErr == nil {
+				return nil, errors.Wrapf(ErrConflict, "[UpdateUser] user ID=%d was modified concurrently", id)
+			}
+		}
+		return nil, errors.Wrapf(ErrNotFound, "[UpdateUser] no user found with ID=%d", id)
+	} else if err != nil {
+		return nil, errors.Wrap(err, "[UpdateUser] update scan fail")
+	}
+	return u, nil
+}
+
+// RecordLogin 로그인 횟수(visits_count)를 원자적으로 1 증가하고 last_login_at 기록
+func (r *PostgresUserRepository) RecordLogin(ctx context.Context, id int, at time.Time) error {
+	_, err := r.db.Pool.Exec(ctx, `
+		UPDATE users
+		   SET visits_count = visits_count + 1,
+		       last_login_at = $2,
+		       last_seen_at = $2
+		 WHERE id=$1
+	`, id, at)
+	if err != nil {
+		return errors.Wrap(err, "[RecordLogin] exec fail")
+	}
+	return nil
+}
+
+// CompleteOnboarding 온보딩 완료 시각 기록 (이미 완료했으면 그대로 유지)
+func (r *PostgresUserRepository) CompleteOnboarding(ctx context.Context, id int) error {
+	_, err := r.db.Pool.Exec(ctx, `
+		UPDATE users
+		   SET onboarded_at = COALESCE(onboarded_at, NOW())
+		 WHERE id=$1
+	`, id)
+	if err != nil {
+		return errors.Wrap(err, "[CompleteOnboarding] exec fail")
+	}
+	return nil
+}
+
+// SoftDeleteUser 탈퇴 처리: deleted_at 기록, purgeAfter 이후 익명화 대상이 됨
+func (r *PostgresUserRepository) SoftDeleteUser(ctx context.Context, id int, purgeAfter time.Time) error {
+	tag, err := r.db.Pool.Exec(ctx, `
+		UPDATE users
+		   SET deleted_at=NOW(),
+		       purge_after=$2,
+		       updated_at=NOW()
+		 WHERE id=$1
+		   AND deleted_at IS NULL
+	`, id, purgeAfter)
+	if err != nil {
+		return errors.Wrap(err, "[SoftDeleteUser] exec fail")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf("[SoftDeleteUser] no active user found with ID=%d", id)
+	}
+	return nil
+}
+
+// RestoreUser 유예 기간 중인(익명화 전) 탈퇴 유저를 복구
+func (r *PostgresUserRepository) RestoreUser(ctx context.Context, id int) error {
+	tag, err := r.db.Pool.Exec(ctx, `
+		UPDATE users
+		   SET deleted_at=NULL,
+		       purge_after=NULL,
+		       updated_at=NOW()
+		 WHERE id=$1
+		   AND deleted_at IS NOT NULL
+		   AND anonymized_at IS NULL
+	`, id)
+	if err != nil {
+		return errors.Wrap(err, "[RestoreUser] exec fail")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf("[RestoreUser] no restorable user found with ID=%d", id)
+	}
+	return nil
 }
 
-func (r *PostgresUserRepository) ListAllUsers(ctx context.Context) ([]model.User, error) {
+// ListUsersToAnonymize 유예 기간(purge_after)이 지난 탈퇴 유저를 최대 limit 명 조회
+func (r *PostgresUserRepository) ListUsersToAnonymize(ctx context.Context, now time.Time, limit int) ([]model.User, error) {
 	rows, err := r.db.Pool.Query(ctx, `
-        SELECT id, oauth_provider, email, name, nickname, profile_image,
-               role, visits_count, created_at, updated_at
-          FROM users
-        ORDER BY id
-    `)
+		SELECT `+userColumns+`
+		  FROM users
+		 WHERE deleted_at IS NOT NULL
+		   AND anonymized_at IS NULL
+		   AND purge_after <= $1
+		 ORDER BY purge_after
+		 LIMIT $2
+	`, now, limit)
 	if err != nil {
-		return nil, errors.Wrap(err, "[ListAllUsers] query failed")
+		return nil, errors.Wrap(err, "[ListUsersToAnonymize] query failed")
 	}
 	defer rows.Close()
 
 	var results []model.User
 	for rows.Next() {
-		var u model.User
-		if err := rows.Scan(&u.ID, &u.OauthProvider, &u.Email, &u.Name, &u.Nickname, &u.ProfileImage,
-			&u.Role, &u.VisitsCount, &u.CreatedAt, &u.UpdatedAt); err != nil {
-			return nil, errors.Wrap(err, "[ListAllUsers] row scan failed")
+		u, err := scanUser(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "[ListUsersToAnonymize] row scan failed")
 		}
-		results = append(results, u)
+		results = append(results, *u)
 	}
 	if err := rows.Err(); err != nil {
-		return nil, errors.Wrap(err, "[ListAllUsers] rows iteration error")
+		return nil, errors.Wrap(err, "[ListUsersToAnonymize] rows iteration error")
 	}
 	return results, nil
 }
 
-func (r *PostgresUserRepository) UpdateUser(ctx context.Context, user *model.User) error {
+// AnonymizeUser 개인정보를 영구 삭제 (email 은 NOT NULL UNIQUE 이므로 식별 불가능한 값으로 대체)
+func (r *PostgresUserRepository) AnonymizeUser(ctx context.Context, id int) error {
+	// 예전 handle 도 프로필 URL 로 추적할 수 없도록 이력 삭제
 	_, err := r.db.Pool.Exec(ctx, `
+		WITH history AS (
+		    DELETE FROM user_handle_history WHERE user_id = $1
+		)
 		UPDATE users
-		   SET oauth_provider=$2,
-		       name=$3,
-		       nickname=$4,
-		       profile_image=$5,
-		       role=$6,
-		       visits_count=$7,
+		   SET email='deleted-' || id || '@anonymized.invalid',
+		       name='',
+		       nickname='',
+		       profile_image='',
+		       avatar_key='',
+		       display_name='',
+		       bio='',
+		       handle='user' || id,
+		       anonymized_at=NOW(),
 		       updated_at=NOW()
 		 WHERE id=$1
-	`,
-		user.ID,
-		user.OauthProvider,
-		user.Name,
-		user.Nickname,
-		user.ProfileImage,
-		user.Role,
-		user.VisitsCount,
-	)
+		   AND deleted_at IS NOT NULL
+	`, id)
 	if err != nil {
-		return errors.Wrap(err, "[UpdateUser] exec fail")
+		return errors.Wrap(err, "[AnonymizeUser] exec fail")
 	}
 	return nil
 }
+
+// ListUsersWithProviderAvatar 프로필 이미지가 아직 OAuth 제공자 URL 인 활성 유저 (afterID 이후 ID 순)
+func (r *PostgresUserRepository) ListUsersWithProviderAvatar(ctx context.Context, afterID, limit int) ([]model.User, error) {
+	rows, err := r.db.Pool.Query(ctx, `
+		SELECT `+userColumns+`
+		  FROM users
+		 WHERE id > $1
+		   AND avatar_key = ''
+		   AND profile_image LIKE 'http%'
+		   AND deleted_at IS NULL
+		 ORDER BY id
+		 LIMIT $2
+	`, afterID, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ListUsersWithProviderAvatar] query failed")
+	}
+	defer rows.Close()
+
+	var results []model.User
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "[ListUsersWithProviderAvatar] row scan failed")
+		}
+		results = append(results, *u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "[ListUsersWithProviderAvatar] rows iteration error")
+	}
+	return results, nil
+}
+
+// Search 닉네임/표시 이름(관리자는 이름/이메일 포함) 부분 일치·유사도·초성 검색, 관련도 순
+// 탈퇴한 유저는 제외, 영구 차단된 유저는 관리자 검색에서만 포함 (공개 프로필이 404 라서)
+// 점수: 필드별 trigram 유사도 최댓값 + 닉네임 완전 일치(1.0)/접두 일치(0.5) + 초성 접두 일치(0.4)
+func (r *PostgresUserRepository) Search(ctx context.Context, search model.UserSearch) ([]model.UserSearchResult, error) {
+	choseongQuery := ""
+	if hangul.IsChoseongOnly(search.Query) {
+		choseongQuery = strings.ReplaceAll(search.Query, " ", "")
+	}
+
+	// $1: 검색어, $2: 관리자 검색 여부, $3: LIKE 이스케이프한 소문자 검색어, $4: 초성 검색어, $5: limit, $6: 검색하는 유저, $7: BANNED
+	rows, err := r.db.Pool.Query(ctx, `
+		SELECT `+userColumns+`,
+		       GREATEST(
+		           similarity(nickname, $1),
+		           similarity(display_name, $1),
+		           CASE WHEN $2 THEN GREATEST(similarity(name, $1), similarity(lower(email), lower($1))) ELSE 0 END
+		       )
+		       + CASE WHEN lower(nickname) = lower($1) THEN 1.0
+		              WHEN lower(nickname) LIKE $3 || '%' THEN 0.5
+		              ELSE 0 END
+		       + CASE WHEN $4 <> '' AND nickname_choseong LIKE $4 || '%' THEN 0.4 ELSE 0 END
+		       AS score
+		  FROM users
+		 WHERE deleted_at IS NULL
+		   AND ($2 OR status <> $7)
+		   AND (
+		         nickname ILIKE '%' || $3 || '%'
+		      OR display_name ILIKE '%' || $3 || '%'
+		      OR nickname % $1
+		      OR display_name % $1
+		      OR ($2 AND (
+		             name ILIKE '%' || $3 || '%'
+		          OR lower(email) LIKE '%' || $3 || '%'
+		          OR name % $1
+		         ))
+		      OR ($4 <> '' AND (
+		             nickname_choseong LIKE '%' || $4 || '%'
+		          OR display_name_choseong LIKE '%' || $4 || '%'
+		         ))
+		       )
+		   AND `+NotBlockedCondition("users.id", 6)+`
+		 ORDER BY score DESC, id
+		 LIMIT $5
+	`, search.Query, search.IncludePrivate, escapeLike(strings.ToLower(search.Query)), choseongQuery, search.Limit, search.ViewerID,
+		model.UserStatusBanned)
+	if err != nil {
+		return nil, errors.Wrap(err, "[Search] query failed")
+	}
+	defer rows.Close()
+
+	var results []model.UserSearchResult
+	for rows.Next() {
+		var score float64
+		u, err := scanUser(rows, &score)
+		if err != nil {
+			return nil, errors.Wrap(err, "[Search] row scan failed")
+		}
+		results = append(results, model.UserSearchResult{User: *u, Score: score})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "[Search] rows iteration error")
+	}
+	return results, nil
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type PreferenceRepository interface {
+	// Get 저장된 설정 (없으면 빈 map)
+	Get(ctx context.Context, userID int) (map[string]json.RawMessage, error)
+	// Merge set 은 덮어쓰고 unset 키는 삭제(기본값으로 복귀)한 뒤 결과 반환
+	Merge(ctx context.Context, userID int, set map[string]interface{}, unset []string) (map[string]json.RawMessage, error)
+}
@@ -2,11 +2,23 @@ package repository
 
 import (
 	"context"
+	"server/internal/apperror"
 	"server/internal/model"
 )
 
+var (
+	// ErrNotFound 조회 대상 레코드가 없을 때 (404)
+	ErrNotFound = apperror.NotFound("", "")
+	// ErrConflict 동시 수정, 유니크 제약 위반 등으로 요청을 반영할 수 없을 때 (409)
+	ErrConflict = apperror.Conflict("", "")
+	// ErrTooSoon 변경 주기 제한(handle 변경 등) 안에 다시 요청했을 때 (429)
+	ErrTooSoon = apperror.New(apperror.TypeTooManyRequests, "", "")
+)
+
 type RefreshTokenRepository interface {
 	CreateOrUpdate(ctx context.Context, rt *model.RefreshToken) error
 	FindByToken(ctx context.Context, token string) (*model.RefreshToken, error)
 	DeleteByToken(ctx context.Context, token string) error
+	DeleteAllByUserID(ctx context.Context, userID int) error
+	ListByUserID(ctx context.Context, userID int) ([]model.RefreshToken, error)
 }
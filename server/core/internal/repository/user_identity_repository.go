@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"server/internal/model"
+)
+
+type UserIdentityRepository interface {
+	Upsert(ctx context.Context, identity *model.UserIdentity) error
+	ListByUserID(ctx context.Context, userID int) ([]model.UserIdentity, error)
+	DeleteByUserID(ctx context.Context, userID int) error
+}
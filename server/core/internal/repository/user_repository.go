@@ -3,12 +3,24 @@ package repository
 import (
 	"context"
 	"server/internal/model"
+	"server/internal/pagination"
+	"time"
 )
 
 type UserRepository interface {
-	ListAllUsers(ctx context.Context) ([]model.User, error) // <--- 추가
+	ListUsers(ctx context.Context, filter model.UserFilter, page pagination.Request) ([]model.User, error)
+	Search(ctx context.Context, search model.UserSearch) ([]model.UserSearchResult, error)
 	CreateUser(ctx context.Context, user *model.User) (*model.User, error)
 	FindByEmail(ctx context.Context, email string) (*model.User, error)
 	FindByID(ctx context.Context, id int) (*model.User, error)
-	UpdateUser(ctx context.Context, user *model.User) error
+	UpdateUser(ctx context.Context, id int, upd model.UserUpdate) (*model.User, error)
+	RecordLogin(ctx context.Context, id int, at time.Time) error
+	CompleteOnboarding(ctx context.Context, id int) error
+	ListUsersWithProviderAvatar(ctx context.Context, afterID, limit int) ([]model.User, error)
+
+	// 회원 탈퇴 (soft delete → 유예 기간 후 익명화)
+	SoftDeleteUser(ctx context.Context, id int, purgeAfter time.Time) error
+	RestoreUser(ctx context.Context, id int) error
+	ListUsersToAnonymize(ctx context.Context, now time.Time, limit int) ([]model.User, error)
+	AnonymizeUser(ctx context.Context, id int) error
 }
@@ -0,0 +1,19 @@
+// Package requestid 요청 단위 상관관계 ID 를 context 로 전달
+package requestid
+
+import "context"
+
+// Header 요청/응답에서 요청 ID 를 주고받는 헤더
+const Header = "X-Request-ID"
+
+type ctxKey struct{}
+
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// FromContext 요청 ID (없으면 빈 문자열)
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(ctxKey{}).(string)
+	return id
+}
@@ -0,0 +1,73 @@
+package router
+
+// AuthLevel 라우트 인증 요구 수준 (OpenAPI security 로 표시)
+type AuthLevel int
+
+const (
+	AuthNone     AuthLevel = iota
+	AuthOptional           // 비로그인 가능, 로그인 시 응답이 달라짐
+	AuthRequired
+)
+
+// Doc 라우트 문서화 메타데이터 (OpenAPI 문서 생성용)
+//
+//	me.PATCH("/handle", cfg.ProfileHandler.ChangeHandle, Doc{
+//		Summary:  "handle 변경",
+//		Request:  handler.ChangeHandleRequest{},
+//		Response: model.User{},
+//	})
+//
+// Tags, Auth, Role 은 보통 RouteGroup.Describe 로 그룹 단위 지정 (라우트에서 지정하면 덮어씀)
+type Doc struct {
+	Summary     string
+	Description string
+	Tags        []string
+	Auth        AuthLevel
+	Role        string // 필요한 역할 (예: model.RoleAdmin)
+	RateLimit   string // 적용된 rate limit 정책 이름 (RouteGroup.RateLimit 이 지정)
+	Idempotent  bool   // Idempotency-Key 헤더 지원 (RouteGroup.Idempotent 가 지정)
+
+	Query []Param
+
+	// Request JSON 요청 본문 타입의 zero value (Bind 로 해석하는 구조체)
+	Request interface{}
+	// Response JSON 응답 타입의 zero value (nil 이면 임의의 JSON 객체)
+	Response interface{}
+	// Status 성공 응답 코드 (기본 200)
+	Status int
+	// ContentType JSON 이 아닌 응답 (파일 다운로드 등)
+	ContentType string
+}
+
+// Param 쿼리 파라미터
+type Param struct {
+	Name        string
+	Description string
+	Integer     bool
+}
+
+// PageQuery 커서 기반 목록 조회 공통 쿼리 파라미터 (pagination.ParseRequest)
+var PageQuery = []Param{
+	{Name: "limit", Description: "페이지 크기", Integer: true},
+	{Name: "cursor", Description: "이전 응답의 next_cursor"},
+	{Name: "sort", Description: "정렬 (예: -created_at)"},
+}
+
+// merge 그룹 기본값 위에 라우트 Doc 을 덮어씀
+func (d Doc) merge(route Doc) Doc {
+	merged := route
+	if len(merged.Tags) == 0 {
+		merged.Tags = d.Tags
+	}
+	if merged.Auth == AuthNone {
+		merged.Auth = d.Auth
+	}
+	if merged.Role == "" {
+		merged.Role = d.Role
+	}
+	if merged.RateLimit == "" {
+		merged.RateLimit = d.RateLimit
+	}
+	merged.Idempotent = merged.Idempotent || d.Idempotent
+	return merged
+}
@@ -0,0 +1,115 @@
+package router
+
+import (
+	"net/http"
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+// Middleware 핸들러를 감싸는 미들웨어 (AuthMiddleware.Handle, middleware.RequireRole(...) 등)
+type Middleware func(http.Handler) http.Handler
+
+// RouteGroup 공통 경로 prefix 와 미들웨어 스택을 공유하는 라우트 묶음
+// 하위 그룹은 상위 그룹의 미들웨어를 그대로 물려받고 그 안쪽에 자기 미들웨어를 추가
+type RouteGroup struct {
+	router      *Router
+	prefix      string
+	middlewares []Middleware
+	doc         Doc // 하위 라우트에 물려줄 문서 기본값 (Tags, Auth, Role, RateLimit, Idempotent)
+}
+
+// Group prefix 를 이어 붙이고 middlewares 를 추가한 하위 그룹 (먼저 적은 미들웨어가 바깥쪽에서 먼저 실행)
+func (g *RouteGroup) Group(prefix string, middlewares ...Middleware) *RouteGroup {
+	stack := make([]Middleware, 0, len(g.middlewares)+len(middlewares))
+	stack = append(stack, g.middlewares...)
+	stack = append(stack, middlewares...)
+	return &RouteGroup{
+		router:      g.router,
+		prefix:      g.prefix + prefix,
+		middlewares: stack,
+		doc:         g.doc,
+	}
+}
+
+// Describe 같은 prefix·미들웨어에 문서 기본값(Tags, Auth, Role)만 지정한 하위 그룹
+func (g *RouteGroup) Describe(doc Doc) *RouteGroup {
+	sub := g.Group("")
+	sub.doc = g.doc.merge(doc)
+	return sub
+}
+
+// With 같은 prefix 에 미들웨어만 추가한 하위 그룹 (라우트 하나에만 거는 경우에도 사용)
+func (g *RouteGroup) With(middlewares ...Middleware) *RouteGroup {
+	return g.Group("", middlewares...)
+}
+
+// RateLimit 같은 prefix 에 rate_limit.policies.{policy} 정책을 건 하위 그룹 (문서에 429 응답 추가)
+// user 기준 정책은 인증 미들웨어를 가진 그룹에서 호출해야 유저별로 나뉨
+func (g *RouteGroup) RateLimit(policy string) *RouteGroup {
+	sub := g.With(g.router.rateLimit.Policy(policy))
+	sub.doc.RateLimit = policy
+	return sub
+}
+
+// Idempotent 같은 prefix 에 Idempotency-Key 헤더 처리를 건 하위 그룹 (문서에 헤더와 409/422 응답 추가)
+// key 가 유저별로 나뉘도록 인증 미들웨어를 가진 그룹에서 호출 (GET 등 변경하지 않는 요청은 그대로 통과)
+func (g *RouteGroup) Idempotent() *RouteGroup {
+	sub := g.With(g.router.idempotency.Handle)
+	sub.doc.Idempotent = true
+	return sub
+}
+
+func (g *RouteGroup) GET(pattern string, handler http.HandlerFunc, doc ...Doc) {
+	g.Handle(http.MethodGet, pattern, handler, doc...)
+}
+
+func (g *RouteGroup) HEAD(pattern string, handler http.HandlerFunc, doc ...Doc) {
+	g.Handle(http.MethodHead, pattern, handler, doc...)
+}
+
+func (g *RouteGroup) POST(pattern string, handler http.HandlerFunc, doc ...Doc) {
+	g.Handle(http.MethodPost, pattern, handler, doc...)
+}
+
+func (g *RouteGroup) PUT(pattern string, handler http.HandlerFunc, doc ...Doc) {
+	g.Handle(http.MethodPut, pattern, handler, doc...)
+}
+
+func (g *RouteGroup) PATCH(pattern string, handler http.HandlerFunc, doc ...Doc) {
+	g.Handle(http.MethodPatch, pattern, handler, doc...)
+}
+
+func (g *RouteGroup) DELETE(pattern string, handler http.HandlerFunc, doc ...Doc) {
+	g.Handle(http.MethodDelete, pattern, handler, doc...)
+}
+
+// Handle 그룹의 미들웨어 스택을 적용해 라우트 등록 (같은 메서드+경로를 두 번 등록하면 panic)
+// doc 은 OpenAPI 문서용 메타데이터 (하나만 사용, 그룹 기본값과 합쳐짐)
+func (g *RouteGroup) Handle(method, pattern string, handler http.HandlerFunc, doc ...Doc) {
+	d := g.doc
+	if len(doc) > 0 {
+		d = g.doc.merge(doc[0])
+	}
+
+	var h http.Handler = handler
+	names := make([]string, len(g.middlewares))
+	for i := len(g.middlewares) - 1; i >= 0; i-- {
+		h = g.middlewares[i](h)
+		names[i] = funcName(g.middlewares[i])
+	}
+	g.router.handle(method, g.prefix+pattern, h, funcName(handler), names, d)
+}
+
+// funcName 라우트 테이블 출력용 함수 이름 (예: handler.(*UserHandler).HandleMe)
+func funcName(fn interface{}) string {
+	f := runtime.FuncForPC(reflect.ValueOf(fn).Pointer())
+	if f == nil {
+		return "?"
+	}
+	name := strings.TrimSuffix(f.Name(), "-fm")
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
@@ -0,0 +1,221 @@
+package router
+
+import (
+	"crypto/sha256"
+	_ "embed"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"server/internal/apperror"
+	"server/internal/middleware"
+	"server/internal/openapi"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const securitySchemeName = "accessToken"
+
+// docsHTML 스펙을 읽어 화면에 그리는 단일 HTML (외부 CDN 없이 동작)
+//
+//go:embed docs.html
+var docsHTML []byte
+
+// docsCSP 문서 화면 전용 CSP (인라인 <script>, <style> 은 내용 해시로만 허용, 스펙은 같은 출처에서 fetch)
+var docsCSP = buildDocsCSP(docsHTML)
+
+// {key...} 같은 ServeMux 와일드카드 (OpenAPI 경로에는 {key} 로 표기)
+var pathParamPattern = regexp.MustCompile(`\{(\w+)(\.\.\.)?\}`)
+
+// OpenAPI 등록된 라우트와 Doc 메타데이터로 OpenAPI 3.1 문서 생성
+// Doc 이 없는 라우트도 경로/메서드만으로 포함됨 (Undocumented 로 확인)
+func (r *Router) OpenAPI() *openapi.Document {
+	schemas := openapi.NewSchemas()
+	errorSchema := schemas.Named("ErrorResponse", apperror.Response{})
+
+	doc := &openapi.Document{
+		OpenAPI: openapi.Version,
+		Info: openapi.Info{
+			Title:       "Step Journey API",
+			Version:     "v1",
+			Description: "에러 응답은 모두 ErrorResponse 형식 (code 로 구분, 입력값 오류는 details.fields 에 필드별 사유)",
+		},
+		Paths: make(map[string]*openapi.PathItem),
+		Components: openapi.Components{
+			SecuritySchemes: map[string]*openapi.SecurityScheme{
+				securitySchemeName: {
+					Type:        "apiKey",
+					In:          "cookie",
+					Name:        r.accessToken,
+					Description: "OAuth 로그인 후 발급되는 JWT (만료 시 refresh_token 쿠키로 자동 재발급, cookie.prefix 설정에 따라 __Host-/__Secure- 가 붙음)",
+				},
+			},
+		},
+	}
+
+	tags := make(map[string]bool)
+	operationIDs := make(map[string]int)
+	for _, info := range r.Routes() {
+		e := r.routes[info.Pattern].endpoints[info.Method]
+		path := pathParamPattern.ReplaceAllString(info.Pattern, "{$1}")
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = &openapi.PathItem{}
+		}
+
+		op := buildOperation(schemas, errorSchema, info, e.doc)
+		op.OperationID = operationID(info.Handler, operationIDs)
+		(*doc.Paths[path])[strings.ToLower(info.Method)] = op
+		for _, tag := range op.Tags {
+			tags[tag] = true
+		}
+	}
+
+	for tag := range tags {
+		doc.Tags = append(doc.Tags, openapi.Tag{Name: tag})
+	}
+	sort.Slice(doc.Tags, func(i, j int) bool { return doc.Tags[i].Name < doc.Tags[j].Name })
+
+	doc.Components.Schemas = schemas.Components()
+	return doc
+}
+
+func buildOperation(schemas *openapi.Schemas, errorSchema *openapi.Schema, info RouteInfo, d Doc) *openapi.Operation {
+	op := &openapi.Operation{
+		Summary:     d.Summary,
+		Description: d.Description,
+		Tags:        d.Tags,
+		Responses:   make(map[string]*openapi.Response),
+	}
+
+	for _, m := range pathParamPattern.FindAllStringSubmatch(info.Pattern, -1) {
+		schema := &openapi.Schema{Type: "string"}
+		if m[1] == "id" {
+			schema = &openapi.Schema{Type: "integer", Format: "int32"}
+		}
+		op.Parameters = append(op.Parameters, openapi.Parameter{Name: m[1], In: "path", Required: true, Schema: schema})
+	}
+	for _, q := range d.Query {
+		schema := &openapi.Schema{Type: "string"}
+		if q.Integer {
+			schema = &openapi.Schema{Type: "integer", Format: "int32"}
+		}
+		op.Parameters = append(op.Parameters, openapi.Parameter{Name: q.Name, In: "query", Description: q.Description, Schema: schema})
+	}
+
+	if d.Request != nil {
+		op.RequestBody = &openapi.RequestBody{
+			Required: true,
+			Content:  map[string]openapi.MediaType{"application/json": {Schema: schemas.Of(d.Request)}},
+		}
+	}
+
+	status := d.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	success := &openapi.Response{Description: http.StatusText(status)}
+	switch {
+	case strings.HasPrefix(d.ContentType, "text/"):
+		success.Content = map[string]openapi.MediaType{d.ContentType: {Schema: &openapi.Schema{Type: "string"}}}
+	case d.ContentType != "":
+		success.Content = map[string]openapi.MediaType{d.ContentType: {Schema: &openapi.Schema{Type: "string", Format: "binary"}}}
+	case status == http.StatusNoContent || (status >= 300 && status < 400):
+	case d.Response != nil:
+		success.Content = map[string]openapi.MediaType{"application/json": {Schema: schemas.Of(d.Response)}}
+	default:
+		success.Content = map[string]openapi.MediaType{"application/json": {Schema: &openapi.Schema{Type: "object"}}}
+	}
+	op.Responses[strconv.Itoa(status)] = success
+
+	errorResponse := func(status int, description string) {
+		op.Responses[strconv.Itoa(status)] = &openapi.Response{
+			Description: description,
+			Content:     map[string]openapi.MediaType{"application/json": {Schema: errorSchema}},
+		}
+	}
+	if d.Request != nil {
+		errorResponse(http.StatusBadRequest, "요청 본문을 해석할 수 없음 (INVALID_BODY)")
+		errorResponse(http.StatusUnprocessableEntity, "입력값 검증 실패 (VALIDATION_FAILED, details.fields)")
+	}
+	switch d.Auth {
+	case AuthRequired:
+		op.Security = []map[string][]string{{securitySchemeName: {}}}
+		errorResponse(http.StatusUnauthorized, "로그인 필요")
+	case AuthOptional:
+		op.Security = []map[string][]string{{}, {securitySchemeName: {}}}
+	}
+	if d.Role != "" {
+		op.Description = strings.TrimSpace(op.Description + "\n\n필요한 역할: " + d.Role)
+		errorResponse(http.StatusForbidden, "권한 없음 (ROLE_REQUIRED)")
+	}
+	// 변경하지 않는 요청은 Idempotency-Key 헤더를 무시
+	if d.Idempotent && info.Method != http.MethodGet && info.Method != http.MethodHead {
+		op.Parameters = append(op.Parameters, openapi.Parameter{
+			Name:        middleware.IdempotencyKeyHeader,
+			In:          "header",
+			Description: "재시도마다 같은 값 (최대 255자, 보관 기간 내 재시도하면 처음 응답을 " + middleware.IdempotentReplayedHeader + ": true 헤더와 함께 재전송)",
+			Schema:      &openapi.Schema{Type: "string"},
+		})
+		errorResponse(http.StatusConflict, "같은 Idempotency-Key 의 요청 처리 중 (IDEMPOTENCY_KEY_IN_USE, Retry-After 헤더)")
+		errorResponse(http.StatusUnprocessableEntity, "다른 요청에 사용한 Idempotency-Key (IDEMPOTENCY_KEY_REUSED)")
+	}
+	if d.RateLimit != "" {
+		errorResponse(http.StatusTooManyRequests, "요청 한도 초과 (TOO_MANY_REQUESTS, Retry-After 헤더, 정책: "+d.RateLimit+")")
+	}
+	op.Responses["default"] = &openapi.Response{
+		Description: "에러",
+		Content:     map[string]openapi.MediaType{"application/json": {Schema: errorSchema}},
+	}
+	return op
+}
+
+// operationID 핸들러 이름에서 패키지/리시버 표기를 뺀 값 (handler.(*UserHandler).HandleMe → UserHandler.HandleMe)
+// 한 핸들러를 여러 라우트에 등록한 경우 뒤에 번호를 붙임
+func operationID(handlerName string, seen map[string]int) string {
+	id := strings.NewReplacer("(*", "", ")", "").Replace(handlerName)
+	if i := strings.IndexByte(id, '.'); i >= 0 {
+		id = id[i+1:]
+	}
+	seen[id]++
+	if n := seen[id]; n > 1 {
+		id += strconv.Itoa(n)
+	}
+	return id
+}
+
+// serveOpenAPI GET /api/v1/openapi.json (라우트 등록이 끝난 뒤 처음 요청 시 한 번 생성)
+func (r *Router) serveOpenAPI(w http.ResponseWriter, req *http.Request) {
+	r.specOnce.Do(func() {
+		r.spec, r.specErr = json.Marshal(r.OpenAPI())
+	})
+	if r.specErr != nil {
+		apperror.Write(w, req, apperror.Internal(errors.Wrap(r.specErr, "[Router.serveOpenAPI] marshal spec failed")))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(r.spec)
+}
+
+// serveDocs GET /api/v1/docs (prod 제외)
+// 보안 헤더 미들웨어의 API 용 CSP(default-src 'none')를 문서 화면용으로 교체
+func serveDocs(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Security-Policy", docsCSP)
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(docsHTML)
+}
+
+func buildDocsCSP(html []byte) string {
+	inlineHash := func(tag string) string {
+		m := regexp.MustCompile(`(?s)<` + tag + `>(.*?)</` + tag + `>`).FindSubmatch(html)
+		if m == nil {
+			return "'none'"
+		}
+		sum := sha256.Sum256(m[1])
+		return "'sha256-" + base64.StdEncoding.EncodeToString(sum[:]) + "'"
+	}
+	return "default-src 'none'; script-src " + inlineHash("script") + "; style-src " + inlineHash("style") +
+		"; connect-src 'self'; img-src 'self' data:; base-uri 'none'; form-action 'none'; frame-ancestors 'none'"
+}
@@ -2,27 +2,75 @@ package router
 
 import (
 	"net/http"
+	"server/internal/apperror"
+	"server/internal/cookie"
 	"server/internal/handler"
 	"server/internal/middleware"
+	"server/internal/model"
+	"server/internal/pagination"
+	"server/pkg/logger"
+	"sort"
 	"strings"
+	"sync"
+
+	"github.com/rs/zerolog"
 )
 
 type Config struct {
-	AuthHandler    *handler.AuthHandler
-	HealthHandler  *handler.HealthHandler
-	UserHandler    *handler.UserHandler
-	AuthMiddleware *middleware.AuthMiddleware
+	AuthHandler         *handler.AuthHandler
+	HealthHandler       *handler.HealthHandler
+	UserHandler         *handler.UserHandler
+	AccountHandler      *handler.AccountHandler
+	DataExportHandler   *handler.DataExportHandler
+	ConsentHandler      *handler.ConsentHandler
+	AvatarHandler       *handler.AvatarHandler
+	MediaHandler        *handler.MediaHandler
+	PreferenceHandler   *handler.PreferenceHandler
+	AdminHandler        *handler.AdminHandler
+	FollowHandler       *handler.FollowHandler
+	BlockHandler        *handler.BlockHandler
+	NotificationHandler *handler.NotificationHandler
+	ProfileHandler      *handler.ProfileHandler
+	AuthMiddleware      *middleware.AuthMiddleware
+	// RateLimitMiddleware 없으면(openapi 명령 등) 제한 없이 등록
+	RateLimitMiddleware *middleware.RateLimitMiddleware
+	// IdempotencyMiddleware 없으면(openapi 명령 등) Idempotency-Key 헤더를 무시하고 등록
+	IdempotencyMiddleware *middleware.IdempotencyMiddleware
+	// Cookies 인증 쿠키 정책 (OpenAPI securitySchemes 의 쿠키 이름, 없으면 prefix 없는 이름)
+	Cookies *cookie.Policy
+
+	// EnableDocs /api/v1/docs 문서 화면 제공 여부 (prod 제외)
+	EnableDocs bool
 }
 
+// Router 경로 패턴(Go 1.22 ServeMux 문법, 예: /users/{id}, /media/{key...})별로 메서드 분기
+// 같은 경로의 메서드들은 하나의 mux 패턴으로 묶어 405 + Allow 헤더를 직접 응답
 type Router struct {
-	mux     *http.ServeMux
-	routes  map[string]*route
-	options *routerOptions
+	*RouteGroup
+
+	mux         *http.ServeMux
+	routes      map[string]*route
+	options     *routerOptions
+	rateLimit   *middleware.RateLimitMiddleware
+	idempotency *middleware.IdempotencyMiddleware
+	accessToken string // access token 쿠키의 실제 이름
+
+	// /api/v1/openapi.json 응답 캐시 (라우트는 NewRouter 이후 바뀌지 않음)
+	specOnce sync.Once
+	spec     []byte
+	specErr  error
 }
 
 type route struct {
-	pattern string
-	methods map[string]http.HandlerFunc
+	pattern   string
+	endpoints map[string]*endpoint
+}
+
+type endpoint struct {
+	handler     http.Handler
+	handlerName string
+	middlewares []string
+	doc         Doc
 }
 
 type routerOptions struct {
@@ -32,10 +80,17 @@ type routerOptions struct {
 
 func NewRouter(cfg Config) *Router {
 	r := &Router{
-		mux:     http.NewServeMux(),
-		routes:  make(map[string]*route),
-		options: defaultOptions(),
+		mux:         http.NewServeMux(),
+		routes:      make(map[string]*route),
+		options:     defaultOptions(),
+		rateLimit:   cfg.RateLimitMiddleware,
+		idempotency: cfg.IdempotencyMiddleware,
+		accessToken: cookie.AccessToken,
 	}
+	if cfg.Cookies != nil {
+		r.accessToken = cfg.Cookies.Name(cookie.AccessToken)
+	}
+	r.RouteGroup = &RouteGroup{router: r}
 
 	r.setupRoutes(cfg)
 	r.registerRoutesToMux()
@@ -43,87 +98,285 @@ func NewRouter(cfg Config) *Router {
 }
 
 func (r *Router) setupRoutes(cfg Config) {
+	auth := cfg.AuthMiddleware
+	api := r.Group("/api/v1")
+
 	// Health Check
-	r.GET("/api/v1/health", cfg.HealthHandler.ServeHTTP)
+	api.GET("/health", cfg.HealthHandler.ServeHTTP, Doc{Summary: "헬스 체크", Tags: []string{"system"}})
 
-	// Auth Routes
-	r.GET("/api/v1/auth/google/login", cfg.AuthHandler.HandleGoogleLogin)
-	r.GET("/api/v1/auth/google/callback", cfg.AuthHandler.HandleGoogleCallback)
-	r.GET("/api/v1/auth/kakao/login", cfg.AuthHandler.HandleKakaoLogin)
-	r.GET("/api/v1/auth/kakao/callback", cfg.AuthHandler.HandleKakaoCallback)
-	r.GET("/api/v1/auth/naver/login", cfg.AuthHandler.HandleNaverLogin)
-	r.GET("/api/v1/auth/naver/callback", cfg.AuthHandler.HandleNaverCallback)
-	r.POST("/api/v1/auth/logout", cfg.AuthHandler.HandleLogout)
-
-	// Users Routes (with Auth)
-	r.GET("/api/v1/users", withMiddleware(cfg.AuthMiddleware, cfg.UserHandler.ListUsers))
-	r.POST("/api/v1/users", withMiddleware(cfg.AuthMiddleware, cfg.UserHandler.CreateUser))
-	r.GET("/api/v1/users/me", withMiddleware(cfg.AuthMiddleware, cfg.UserHandler.HandleMe))
-}
+	// API 문서 (스펙은 항상 제공, 화면은 prod 제외)
+	api.GET("/openapi.json", r.serveOpenAPI, Doc{Summary: "OpenAPI 3.1 스펙", Tags: []string{"system"}})
+	if cfg.EnableDocs {
+		api.GET("/docs", serveDocs, Doc{Summary: "API 문서 화면", Tags: []string{"system"}, ContentType: "text/html"})
+	}
 
-func (r *Router) Group(prefix string) *RouteGroup {
-	return &RouteGroup{
-		router: r,
-		prefix: prefix,
+	// Auth Routes
+	authRoutes := api.Group("/auth").RateLimit("auth").Describe(Doc{Tags: []string{"auth"}})
+	for _, provider := range []struct {
+		name  string
+		login http.HandlerFunc
+		cb    http.HandlerFunc
+	}{
+		{"google", cfg.AuthHandler.HandleGoogleLogin, cfg.AuthHandler.HandleGoogleCallback},
+		{"kakao", cfg.AuthHandler.HandleKakaoLogin, cfg.AuthHandler.HandleKakaoCallback},
+		{"naver", cfg.AuthHandler.HandleNaverLogin, cfg.AuthHandler.HandleNaverCallback},
+	} {
+		authRoutes.GET("/"+provider.name+"/login", provider.login, Doc{
+			Summary: provider.name + " 로그인 시작",
+			Status:  http.StatusFound,
+		})
+		authRoutes.GET("/"+provider.name+"/callback", provider.cb, Doc{
+			Summary:     provider.name + " OAuth 콜백",
+			Description: "로그인 쿠키를 설정하고 프론트엔드로 리다이렉트 (탈퇴 유예/정지 계정은 안내 쿼리 포함)",
+			Status:      http.StatusFound,
+		})
 	}
-}
+	authRoutes.POST("/logout", cfg.AuthHandler.HandleLogout, Doc{Summary: "로그아웃 (인증 쿠키 삭제)", ContentType: "text/plain"})
+	authRoutes.POST("/restore", cfg.AuthHandler.HandleRestoreAccount, Doc{
+		Summary:     "탈퇴 유예 중인 계정 복구",
+		Description: "restore_token 쿠키 필요",
+	})
 
-type RouteGroup struct {
-	router *Router
-	prefix string
-}
+	// 약관 문서, 업로드 파일 (프로필 이미지 등)
+	api.RateLimit("public").GET("/legal/documents", cfg.ConsentHandler.ListDocuments, Doc{Summary: "현재 시행 중인 약관 문서 목록", Tags: []string{"consents"}})
+	api.GET("/media/{key...}", cfg.MediaHandler.Serve, Doc{
+		Summary:     "업로드 파일 조회",
+		Description: "private/ 접두사 키(내보내기 아카이브 등)는 404",
+		Tags:        []string{"media"},
+		ContentType: "image/*",
+	})
 
-func (g *RouteGroup) GET(pattern string, handler http.HandlerFunc) {
-	g.router.handle(http.MethodGet, g.prefix+pattern, handler)
-}
+	// 공개 프로필 (비로그인 가능, 로그인 시 팔로워 공개 범위/차단 관계 반영)
+	api.With(auth.OptionalAuth).RateLimit("public").GET("/profiles/{handle}", cfg.ProfileHandler.GetProfile, Doc{
+		Summary:     "공개 프로필 조회",
+		Description: "탈퇴/영구 차단/차단 관계면 404, 공개 범위 밖이면 handle/닉네임/프로필 이미지만 응답 (restricted: true). 예전 handle 이면 공개 범위 안의 viewer 에게만 현재 handle 로 301 리다이렉트 (그 외 404)",
+		Tags:        []string{"profiles"},
+		Auth:        AuthOptional,
+	})
 
-func (g *RouteGroup) POST(pattern string, handler http.HandlerFunc) {
-	g.router.handle(http.MethodPost, g.prefix+pattern, handler)
-}
+	// Users Routes: 전부 인증 필요
+	users := api.Group("/users", auth.HandleSkipConsent).RateLimit("api").Describe(Doc{Tags: []string{"users"}, Auth: AuthRequired})
 
-func (r *Router) GET(pattern string, handler http.HandlerFunc) {
-	r.handle(http.MethodGet, pattern, handler)
-}
+	// 약관 미동의 상태에서도 호출 가능 (회원 탈퇴, 개인정보 내보내기, 약관 동의)
+	users.DELETE("/me", cfg.AccountHandler.DeleteMe, Doc{Summary: "회원 탈퇴", Tags: []string{"account"}})
+	exports := users.Idempotent().Describe(Doc{Tags: []string{"account"}})
+	exports.POST("/me/export", cfg.DataExportHandler.RequestExport, Doc{
+		Summary:     "개인정보 내보내기 요청",
+		Description: "작업 대기열이 가득 차면 503 (DATA_EXPORT_BUSY)",
+		Status:      http.StatusAccepted,
+	})
+	exports.GET("/me/exports/{id}", cfg.DataExportHandler.GetExport, Doc{Summary: "내보내기 상태 조회"})
+	exports.GET("/me/exports/{id}/download", cfg.DataExportHandler.Download, Doc{
+		Summary:     "내보내기 파일 다운로드",
+		Description: "생성 중이거나 기한이 지났거나 정리된 아카이브는 410 (EXPORT_UNAVAILABLE)",
+		ContentType: "application/zip",
+	})
+	consents := users.Describe(Doc{Tags: []string{"consents"}})
+	consents.GET("/me/consents", cfg.ConsentHandler.ListMyConsents, Doc{Summary: "내 약관 동의 현황"})
+	consents.POST("/me/consents", cfg.ConsentHandler.AcceptConsents, Doc{
+		Summary: "약관 동의",
+		Request: handler.AcceptConsentsRequest{},
+		Status:  http.StatusNoContent,
+	})
+	consents.POST("/me/consents/marketing", cfg.ConsentHandler.UpdateMarketingConsent, Doc{
+		Summary:  "마케팅 수신 동의/철회",
+		Request:  handler.MarketingConsentRequest{},
+		Response: model.MarketingConsent{},
+	})
 
-func (r *Router) POST(pattern string, handler http.HandlerFunc) {
-	r.handle(http.MethodPost, pattern, handler)
+	// 그 외는 필수 약관 동의 필요
+	consented := users.With(auth.RequireConsent)
+	// 전체 필드 + 이메일/제공자 필터라 관리자 전용 (일반 유저는 /search 의 공개 필드만)
+	consented.With(middleware.RequireRole(model.RoleAdmin)).GET("", cfg.UserHandler.ListUsers, Doc{
+		Summary: "유저 목록",
+		Role:    model.RoleAdmin,
+		Query: append([]Param{
+			{Name: "provider", Description: "OAuth 제공자"},
+			{Name: "role", Description: "역할"},
+			{Name: "created_from", Description: "가입일 시작 (RFC3339)"},
+			{Name: "created_to", Description: "가입일 끝 (RFC3339)"},
+			{Name: "email_prefix", Description: "이메일 접두사"},
+			{Name: "nickname_prefix", Description: "닉네임 접두사"},
+		}, PageQuery...),
+		Response: pagination.Page[model.User]{},
+	})
+	consented.Idempotent().POST("", cfg.UserHandler.CreateUser, Doc{Summary: "유저 생성 (로컬 테스트용)", Request: handler.CreateUserRequest{}, Response: model.User{}})
+	consented.GET("/search", cfg.UserHandler.SearchUsers, Doc{
+		Summary:     "유저 검색",
+		Description: "관리자가 아니면 공개 필드만 검색/응답",
+		Query: []Param{
+			{Name: "q", Description: "검색어"},
+			{Name: "limit", Description: "최대 결과 수", Integer: true},
+		},
+	})
+
+	me := consented.Group("/me")
+	me.GET("", cfg.UserHandler.HandleMe, Doc{Summary: "내 프로필 (팔로워/팔로잉 수 포함)"})
+	me.PATCH("", cfg.UserHandler.UpdateMe, Doc{
+		Summary:     "내 프로필 수정",
+		Description: "보내지 않은 필드는 유지, updated_at 이 다르면 409",
+		Request:     handler.UpdateMeRequest{},
+	})
+	me.PATCH("/handle", cfg.ProfileHandler.ChangeHandle, Doc{
+		Summary:     "handle 변경",
+		Description: "30일에 한 번만 변경 가능 (429 HANDLE_CHANGE_TOO_SOON, details.retry_at), 이전 handle 은 180일 동안 다른 유저가 사용할 수 없고 공개 프로필 조회 시 새 handle 로 리다이렉트",
+		Tags:        []string{"profiles"},
+		Request:     handler.ChangeHandleRequest{},
+	})
+	me.POST("/onboarding", cfg.UserHandler.CompleteOnboarding, Doc{Summary: "온보딩 완료"})
+	me.POST("/avatar", cfg.AvatarHandler.Upload, Doc{
+		Summary:     "프로필 이미지 업로드",
+		Description: "multipart/form-data 의 avatar 파일 (JPEG/PNG/GIF)",
+	})
+	me.GET("/follow-requests", cfg.FollowHandler.ListRequests, Doc{
+		Summary:  "받은 팔로우 요청 목록",
+		Tags:     []string{"follows"},
+		Query:    PageQuery,
+		Response: pagination.Page[model.FollowUser]{},
+	})
+	me.POST("/follow-requests/{id}/accept", cfg.FollowHandler.AcceptRequest, Doc{Summary: "팔로우 요청 수락", Tags: []string{"follows"}, Response: model.Follow{}})
+	me.DELETE("/follow-requests/{id}", cfg.FollowHandler.RejectRequest, Doc{Summary: "팔로우 요청 거절", Tags: []string{"follows"}, Status: http.StatusNoContent})
+	me.GET("/blocks", cfg.BlockHandler.ListBlocked, Doc{
+		Summary:  "차단한 유저 목록",
+		Tags:     []string{"blocks"},
+		Query:    PageQuery,
+		Response: pagination.Page[model.RestrictedUser]{},
+	})
+	me.GET("/mutes", cfg.BlockHandler.ListMuted, Doc{
+		Summary:  "뮤트한 유저 목록",
+		Tags:     []string{"blocks"},
+		Query:    PageQuery,
+		Response: pagination.Page[model.RestrictedUser]{},
+	})
+	me.GET("/preferences", cfg.PreferenceHandler.GetMine, Doc{Summary: "내 설정", Tags: []string{"preferences"}})
+	me.PATCH("/preferences", cfg.PreferenceHandler.UpdateMine, Doc{
+		Summary:     "설정 변경",
+		Description: "보낸 키만 변경 (null 이면 기본값으로 복귀)",
+		Tags:        []string{"preferences"},
+		Request:     map[string]interface{}{},
+	})
+
+	// Follow / Block / Mute Routes (모바일 재시도로 알림/이력이 중복되지 않도록 Idempotency-Key 지원)
+	target := consented.Group("/{id}").Idempotent()
+	follows := target.Describe(Doc{Tags: []string{"follows"}})
+	follows.POST("/follow", cfg.FollowHandler.Follow, Doc{Summary: "팔로우 (비공개 계정이면 요청)", Response: model.Follow{}})
+	follows.DELETE("/follow", cfg.FollowHandler.Unfollow, Doc{Summary: "언팔로우", Status: http.StatusNoContent})
+	follows.GET("/followers", cfg.FollowHandler.ListFollowers, Doc{Summary: "팔로워 목록", Query: PageQuery, Response: pagination.Page[model.FollowUser]{}})
+	follows.GET("/following", cfg.FollowHandler.ListFollowing, Doc{Summary: "팔로잉 목록", Query: PageQuery, Response: pagination.Page[model.FollowUser]{}})
+	blocks := target.Describe(Doc{Tags: []string{"blocks"}})
+	blocks.POST("/block", cfg.BlockHandler.Block, Doc{Summary: "차단 (서로의 팔로우 관계도 삭제)", Status: http.StatusNoContent})
+	blocks.DELETE("/block", cfg.BlockHandler.Unblock, Doc{Summary: "차단 해제", Status: http.StatusNoContent})
+	blocks.POST("/mute", cfg.BlockHandler.Mute, Doc{Summary: "뮤트", Status: http.StatusNoContent})
+	blocks.DELETE("/mute", cfg.BlockHandler.Unmute, Doc{Summary: "뮤트 해제", Status: http.StatusNoContent})
+
+	// Notification Routes
+	notifications := api.Group("/notifications", auth.Handle).RateLimit("api").Describe(Doc{Tags: []string{"notifications"}, Auth: AuthRequired})
+	notifications.GET("", cfg.NotificationHandler.List, Doc{
+		Summary:  "알림 목록",
+		Query:    append([]Param{{Name: "unread", Description: "true 면 읽지 않은 알림만"}}, PageQuery...),
+		Response: pagination.Page[model.Notification]{},
+	})
+	notifications.GET("/unread-count", cfg.NotificationHandler.UnreadCount, Doc{Summary: "읽지 않은 알림 수"})
+	notifications.POST("/read-all", cfg.NotificationHandler.MarkAllRead, Doc{Summary: "모두 읽음 처리"})
+	notifications.POST("/{id}/read", cfg.NotificationHandler.MarkRead, Doc{Summary: "읽음 처리", Status: http.StatusNoContent})
+
+	// 관리자 유저 관리 (ADMIN 역할 필요, 조치/메모가 중복 기록되지 않도록 Idempotency-Key 지원)
+	admin := api.Group("/admin", auth.Handle, middleware.RequireRole(model.RoleAdmin)).RateLimit("api").Idempotent().
+		Describe(Doc{Tags: []string{"admin"}, Auth: AuthRequired, Role: model.RoleAdmin})
+	admin.GET("/users/{id}", cfg.AdminHandler.GetUser, Doc{Summary: "유저 상세", Response: model.User{}})
+	admin.POST("/users/{id}/suspend", cfg.AdminHandler.Suspend, Doc{Summary: "기간 정지", Request: handler.SuspendRequest{}, Response: model.User{}})
+	admin.POST("/users/{id}/ban", cfg.AdminHandler.Ban, Doc{Summary: "영구 차단", Request: handler.AdminReasonRequest{}, Response: model.User{}})
+	admin.POST("/users/{id}/reinstate", cfg.AdminHandler.Reinstate, Doc{Summary: "정지/차단 해제", Request: handler.AdminReasonRequest{}, Response: model.User{}})
+	admin.PATCH("/users/{id}/role", cfg.AdminHandler.ChangeRole, Doc{Summary: "역할 변경", Request: handler.ChangeRoleRequest{}, Response: model.User{}})
+	admin.GET("/users/{id}/notes", cfg.AdminHandler.ListNotes, Doc{Summary: "관리자 메모 목록", Response: struct {
+		Items []model.AdminNote `json:"items"`
+	}{}})
+	admin.POST("/users/{id}/notes", cfg.AdminHandler.AddNote, Doc{Summary: "관리자 메모 추가", Request: handler.AdminNoteRequest{}, Response: model.AdminNote{}})
+	admin.GET("/users/{id}/actions", cfg.AdminHandler.ListActions, Doc{Summary: "조치 이력", Response: struct {
+		Items []model.AdminAction `json:"items"`
+	}{}})
+	admin.GET("/metrics/activity", cfg.AdminHandler.ActivityMetrics, Doc{
+		Summary: "활동 지표 (DAU 추이 등)",
+		Query: []Param{
+			{Name: "date", Description: "기준일 YYYY-MM-DD (기본 오늘)"},
+			{Name: "days", Description: "일별 DAU 추이 길이 (최대 90)", Integer: true},
+		},
+		Response: model.ActivityMetrics{},
+	})
 }
 
-func (r *Router) handle(method, pattern string, handler http.HandlerFunc) {
+func (r *Router) handle(method, pattern string, h http.Handler, handlerName string, middlewares []string, doc Doc) {
 	if r.routes[pattern] == nil {
 		r.routes[pattern] = &route{
-			pattern: pattern,
-			methods: make(map[string]http.HandlerFunc),
+			pattern:   pattern,
+			endpoints: make(map[string]*endpoint),
 		}
 	}
-	r.routes[pattern].methods[method] = handler
+	if _, exists := r.routes[pattern].endpoints[method]; exists {
+		panic("[Router.handle] duplicate route: " + method + " " + pattern)
+	}
+	r.routes[pattern].endpoints[method] = &endpoint{
+		handler:     h,
+		handlerName: handlerName,
+		middlewares: middlewares,
+		doc:         doc,
+	}
 }
 
 func (r *Router) registerRoutesToMux() {
 	for pattern, route := range r.routes {
 		r.mux.Handle(pattern, r.createHandler(route))
 	}
+	// 어떤 패턴과도 맞지 않는 경로도 공통 에러 응답으로 404
+	if _, ok := r.routes["/"]; !ok {
+		r.mux.Handle("/", r.options.notFoundHandler)
+	}
 }
 
 func (r *Router) createHandler(route *route) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
-		if h, ok := route.methods[req.Method]; ok {
-			h(w, req)
+		logger.UpdateContext(req.Context(), func(c zerolog.Context) zerolog.Context {
+			return c.Str("route", route.pattern)
+		})
+		if e, ok := route.endpoint(req.Method); ok {
+			e.handler.ServeHTTP(w, req)
 			return
 		}
-		if len(route.methods) == 0 {
+		if len(route.endpoints) == 0 {
 			r.options.notFoundHandler(w, req)
 			return
 		}
-		allowed := make([]string, 0, len(route.methods))
-		for method := range route.methods {
-			allowed = append(allowed, method)
-		}
-		w.Header().Set("Allow", strings.Join(allowed, ", "))
+		w.Header().Set("Allow", strings.Join(route.allowedMethods(), ", "))
 		r.options.methodNotAllowedHandler(w, req)
 	})
 }
 
+// endpoint HEAD 를 따로 등록하지 않았으면 GET 핸들러로 처리 (본문은 net/http 가 버림)
+func (rt *route) endpoint(method string) (*endpoint, bool) {
+	if e, ok := rt.endpoints[method]; ok {
+		return e, true
+	}
+	if method == http.MethodHead {
+		e, ok := rt.endpoints[http.MethodGet]
+		return e, ok
+	}
+	return nil, false
+}
+
+func (rt *route) allowedMethods() []string {
+	allowed := make([]string, 0, len(rt.endpoints)+1)
+	for method := range rt.endpoints {
+		allowed = append(allowed, method)
+	}
+	if _, ok := rt.endpoints[http.MethodGet]; ok {
+		if _, ok := rt.endpoints[http.MethodHead]; !ok {
+			allowed = append(allowed, http.MethodHead)
+		}
+	}
+	sort.Strings(allowed)
+	return allowed
+}
+
 func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	r.mux.ServeHTTP(w, req)
 }
@@ -131,13 +384,11 @@ func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 // Helper functions
 func defaultOptions() *routerOptions {
 	return &routerOptions{
-		notFoundHandler: http.NotFound,
+		notFoundHandler: func(w http.ResponseWriter, r *http.Request) {
+			apperror.Write(w, r, apperror.NotFound("ROUTE_NOT_FOUND", "존재하지 않는 API 입니다."))
+		},
 		methodNotAllowedHandler: func(w http.ResponseWriter, r *http.Request) {
-			http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+			apperror.Write(w, r, apperror.New(apperror.TypeMethodNotAllowed, "", ""))
 		},
 	}
 }
-
-func withMiddleware(m *middleware.AuthMiddleware, h http.HandlerFunc) http.HandlerFunc {
-	return m.Handle(h).ServeHTTP
-}
@@ -0,0 +1,64 @@
+package router
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// RouteInfo 라우트 테이블 한 줄 (디버깅/문서화용)
+type RouteInfo struct {
+	Method      string   `json:"method"`
+	Pattern     string   `json:"pattern"`
+	Handler     string   `json:"handler"`
+	Middlewares []string `json:"middlewares"`
+	Summary     string   `json:"summary,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+// Routes 등록된 라우트 목록 (경로, 메서드 순 정렬)
+func (r *Router) Routes() []RouteInfo {
+	var infos []RouteInfo
+	for pattern, route := range r.routes {
+		for method, e := range route.endpoints {
+			infos = append(infos, RouteInfo{
+				Method:      method,
+				Pattern:     pattern,
+				Handler:     e.handlerName,
+				Middlewares: e.middlewares,
+				Summary:     e.doc.Summary,
+				Tags:        e.doc.Tags,
+			})
+		}
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		if infos[i].Pattern != infos[j].Pattern {
+			return infos[i].Pattern < infos[j].Pattern
+		}
+		return infos[i].Method < infos[j].Method
+	})
+	return infos
+}
+
+// Undocumented 문서화 메타데이터(요약, 태그)가 없는 라우트 (TestRoutesDocumented, openapi --check 에서 실패 처리)
+func (r *Router) Undocumented() []RouteInfo {
+	var missing []RouteInfo
+	for _, info := range r.Routes() {
+		if info.Summary == "" || len(info.Tags) == 0 {
+			missing = append(missing, info)
+		}
+	}
+	return missing
+}
+
+// PrintRoutes 라우트 테이블을 METHOD / PATTERN / HANDLER / MIDDLEWARES 표 형태로 출력
+func (r *Router) PrintRoutes(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "METHOD\tPATTERN\tHANDLER\tMIDDLEWARES")
+	for _, info := range r.Routes() {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", info.Method, info.Pattern, info.Handler, strings.Join(info.Middlewares, " > "))
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,99 @@
+package router
+
+import (
+	"net/http"
+	"server/internal/config"
+	"server/internal/cookie"
+	"testing"
+)
+
+// bodylessMutations 요청 본문 없이 경로만으로 처리하는 변경 라우트 (Doc.Request 가 없어도 되는 경우)
+// 새 POST/PUT/PATCH 라우트가 JSON 본문을 받으면 Doc.Request 를 지정하고, 본문이 없으면 여기에 추가
+var bodylessMutations = map[string]bool{
+	"POST /api/v1/auth/logout":                          true,
+	"POST /api/v1/auth/restore":                         true, // restore_token 쿠키
+	"POST /api/v1/users/me/export":                      true,
+	"POST /api/v1/users/me/onboarding":                  true,
+	"POST /api/v1/users/me/avatar":                      true, // multipart/form-data
+	"POST /api/v1/users/me/follow-requests/{id}/accept": true,
+	"POST /api/v1/users/{id}/follow":                    true,
+	"POST /api/v1/users/{id}/block":                     true,
+	"POST /api/v1/users/{id}/mute":                      true,
+	"POST /api/v1/notifications/read-all":               true,
+	"POST /api/v1/notifications/{id}/read":              true,
+}
+
+// newTestRouter 핸들러를 호출하지 않으므로 openapi 명령과 같이 의존성 없이 라우트 테이블만 구성
+func newTestRouter() *Router {
+	return NewRouter(Config{EnableDocs: true})
+}
+
+func TestRoutesDocumented(t *testing.T) {
+	r := newTestRouter()
+	if len(r.Routes()) == 0 {
+		t.Fatal("no routes registered")
+	}
+	for _, info := range r.Undocumented() {
+		t.Errorf("%s %s (%s) has no summary or tags", info.Method, info.Pattern, info.Handler)
+	}
+}
+
+func TestMutatingRoutesDocumentRequestBody(t *testing.T) {
+	r := newTestRouter()
+	for pattern, route := range r.routes {
+		for method, e := range route.endpoints {
+			switch method {
+			case http.MethodPost, http.MethodPut, http.MethodPatch:
+			default:
+				continue
+			}
+			key := method + " " + pattern
+			if e.doc.Request == nil && !bodylessMutations[key] {
+				t.Errorf("%s (%s) has no Doc.Request (add it, or list the route in bodylessMutations)", key, e.handlerName)
+			}
+			if e.doc.Request != nil && bodylessMutations[key] {
+				t.Errorf("%s is listed in bodylessMutations but documents a request body", key)
+			}
+		}
+	}
+}
+
+func TestOpenAPIOperationIDsUnique(t *testing.T) {
+	spec := newTestRouter().OpenAPI()
+	seen := make(map[string]string)
+	for path, item := range spec.Paths {
+		for method, op := range *item {
+			if prev, ok := seen[op.OperationID]; ok {
+				t.Errorf("operationId %q used by both %s and %s %s", op.OperationID, prev, method, path)
+			}
+			seen[op.OperationID] = method + " " + path
+		}
+	}
+}
+
+func TestOpenAPIAccessTokenCookieName(t *testing.T) {
+	cfg := &config.AppConfig{}
+	cfg.Endpoints.BackendBaseURL = "https://api.step-journey.com"
+	cfg.Cookie.Prefix = cookie.PrefixHost
+	policy, err := cookie.NewPolicy(cfg)
+	if err != nil {
+		t.Fatalf("NewPolicy: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		cookies *cookie.Policy
+		want    string
+	}{
+		{"no policy", nil, "access_token"},
+		{"host prefix", policy, "__Host-access_token"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := NewRouter(Config{Cookies: tt.cookies}).OpenAPI()
+			if got := spec.Components.SecuritySchemes[securitySchemeName].Name; got != tt.want {
+				t.Errorf("securitySchemes.%s.name = %q, want %q", securitySchemeName, got, tt.want)
+			}
+		})
+	}
+}
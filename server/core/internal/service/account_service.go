@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"server/internal/config"
+	"server/internal/model"
+	"server/internal/repository"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// 익명화 작업 1회당 처리할 최대 유저 수
+const purgeBatchSize = 100
+
+// AccountService 회원 탈퇴(soft delete), 유예 기간 후 익명화 처리
+type AccountService struct {
+	cfg              *config.AppConfig
+	userRepo         repository.UserRepository
+	refreshTokenRepo repository.RefreshTokenRepository
+	identityRepo     repository.UserIdentityRepository
+	unlinker         *OAuthUnlinker
+	avatarSvc        *AvatarService
+	adminSvc         *AdminService // 계정 상태 캐시 무효화
+}
+
+func NewAccountService(
+	cfg *config.AppConfig,
+	userRepo repository.UserRepository,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	identityRepo repository.UserIdentityRepository,
+	unlinker *OAuthUnlinker,
+	avatarSvc *AvatarService,
+	adminSvc *AdminService,
+) *AccountService {
+	return &AccountService{
+		cfg:              cfg,
+		userRepo:         userRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		identityRepo:     identityRepo,
+		unlinker:         unlinker,
+		avatarSvc:        avatarSvc,
+		adminSvc:         adminSvc,
+	}
+}
+
+// DeleteAccount 탈퇴 처리: soft delete → 모든 세션 폐기 → OAuth 제공자 연결 끊기
+// 유예 기간(account.deletion_grace_period_days) 동안은 다시 로그인하면 복구할 수 있음
+func (s *AccountService) DeleteAccount(ctx context.Context, userID int) (*model.User, error) {
+	purgeAfter := time.Now().Add(s.cfg.AccountDeletionGracePeriod())
+	if err := s.userRepo.SoftDeleteUser(ctx, userID, purgeAfter); err != nil {
+		return nil, errors.Wrap(err, "[DeleteAccount] soft delete failed")
+	}
+	// 남은 access_token 으로 들어오는 다음 요청부터 바로 401
+	s.adminSvc.InvalidateAccountStatus(userID)
+
+	if err := s.refreshTokenRepo.DeleteAllByUserID(ctx, userID); err != nil {
+		return nil, errors.Wrap(err, "[DeleteAccount] revoke sessions failed")
+	}
+
+	identities, err := s.identityRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "[DeleteAccount] list identities failed")
+	}
+	// 연결 끊기 실패는 탈퇴 자체를 막지 않음 (제공자 측 토큰 만료 등) → 로깅만
+	for _, identity := range identities {
+		if err := s.unlinker.Unlink(ctx, identity); err != nil {
+			log.Ctx(ctx).Warn().Err(err).
+				Int("user_id", userID).
+				Str("provider", identity.Provider).
+				Msg("[DeleteAccount] OAuth unlink failed")
+		}
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "[DeleteAccount] find deleted user failed")
+	}
+	log.Ctx(ctx).Info().Int("user_id", userID).Time("purge_after", purgeAfter).Msg("[DeleteAccount] account soft-deleted")
+	return user, nil
+}
+
+// PurgeExpiredAccounts 유예 기간이 지난 탈퇴 유저를 익명화하고 처리한 수를 반환
+func (s *AccountService) PurgeExpiredAccounts(ctx context.Context) (int, error) {
+	users, err := s.userRepo.ListUsersToAnonymize(ctx, time.Now(), purgeBatchSize)
+	if err != nil {
+		return 0, errors.Wrap(err, "[PurgeExpiredAccounts] list users failed")
+	}
+
+	purged := 0
+	for _, u := range users {
+		if err := s.identityRepo.DeleteByUserID(ctx, u.ID); err != nil {
+			return purged, errors.Wrapf(err, "[PurgeExpiredAccounts] delete identities failed (userID=%d)", u.ID)
+		}
+		if err := s.avatarSvc.DeleteObjects(ctx, u.AvatarKey); err != nil {
+			return purged, errors.Wrapf(err, "[PurgeExpiredAccounts] delete avatar failed (userID=%d)", u.ID)
+		}
+		if err := s.userRepo.AnonymizeUser(ctx, u.ID); err != nil {
+			return purged, errors.Wrapf(err, "[PurgeExpiredAccounts] anonymize failed (userID=%d)", u.ID)
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// RunPurgeLoop account.purge_interval_minutes 주기로 익명화 작업 실행 (ctx 취소 시 종료)
+func (s *AccountService) RunPurgeLoop(ctx context.Context) {
+	interval := s.cfg.AccountPurgeInterval()
+	if interval <= 0 {
+		log.Warn().Msg("[RunPurgeLoop] purge interval is not configured, account purge disabled")
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.purgeOnce(ctx)
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("[RunPurgeLoop] stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purgeOnce panic 이 발생해도 루프가 죽지 않도록 recover
+func (s *AccountService) purgeOnce(ctx context.Context) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			log.Error().Interface("panic", rec).Msg("[purgeOnce] recovered from panic")
+		}
+	}()
+
+	purged, err := s.PurgeExpiredAccounts(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("[purgeOnce] purge expired accounts failed")
+	}
+	if purged > 0 {
+		log.Info().Int("count", purged).Msg("[purgeOnce] anonymized expired accounts")
+	}
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"server/internal/cache"
+	"server/internal/model"
+	"server/internal/repository"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// 같은 유저의 last_seen_at 은 이 간격보다 자주 기록하지 않음 (요청마다 UPDATE 방지)
+	activityTouchInterval = 5 * time.Minute
+	activityWriteTimeout  = 5 * time.Second
+
+	activityMetricsDefaultDays = 14
+	activityMetricsMaxDays     = 90
+)
+
+// 일일 활동 날짜 기준 시간대 (한국 서비스 기준 자정에 날짜가 바뀜)
+var activityLocation = mustLoadLocation("Asia/Seoul")
+
+// ActivityService 로그인/활동 기록 및 관리자 지표
+type ActivityService struct {
+	userRepo     repository.UserRepository
+	activityRepo repository.ActivityRepository
+
+	// userID → 마지막으로 기록한 시각 (activityTouchInterval 이 지나면 만료)
+	lastTouch *cache.TTL[int, time.Time]
+}
+
+func NewActivityService(userRepo repository.UserRepository, activityRepo repository.ActivityRepository) *ActivityService {
+	return &ActivityService{
+		userRepo:     userRepo,
+		activityRepo: activityRepo,
+		lastTouch:    cache.NewTTL[int, time.Time](activityTouchInterval),
+	}
+}
+
+// RecordLogin OAuth 로그인 시 호출: visits_count/last_login_at + 일일 활동(login_count)
+func (s *ActivityService) RecordLogin(ctx context.Context, userID int) error {
+	now := time.Now()
+	if err := s.userRepo.RecordLogin(ctx, userID, now); err != nil {
+		return errors.Wrap(err, "[RecordLogin] record user login failed")
+	}
+	if err := s.activityRepo.Touch(ctx, userID, activityDate(now), now, true); err != nil {
+		return errors.Wrap(err, "[RecordLogin] touch activity failed")
+	}
+	s.lastTouch.Set(userID, now)
+	return nil
+}
+
+// Touch 인증된 요청마다 AuthMiddleware 가 호출, 스로틀 간격이 지났을 때만 비동기로 기록
+func (s *ActivityService) Touch(userID int) {
+	now := time.Now()
+	// 날짜가 바뀌면 간격과 무관하게 새 날짜의 활동 행을 남김
+	// (동시에 들어온 요청이 함께 기록할 수 있지만 같은 날짜 행을 갱신하므로 무해)
+	if last, ok := s.lastTouch.Get(userID); ok && activityDate(last).Equal(activityDate(now)) {
+		return
+	}
+	s.lastTouch.Set(userID, now)
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), activityWriteTimeout)
+		defer cancel()
+		if err := s.activityRepo.Touch(ctx, userID, activityDate(now), now, false); err != nil {
+			log.Warn().Err(err).Int("user_id", userID).Msg("[ActivityService.Touch] record activity failed")
+		}
+	}()
+}
+
+// CompleteOnboarding 첫 로그인 온보딩 완료 처리
+func (s *ActivityService) CompleteOnboarding(ctx context.Context, userID int) error {
+	return s.userRepo.CompleteOnboarding(ctx, userID)
+}
+
+// Metrics 기준일(date, 활동 시간대 기준) 포함 DAU/WAU/MAU, 신규 가입자 수, 최근 days 일 DAU 추이
+func (s *ActivityService) Metrics(ctx context.Context, date time.Time, days int) (*model.ActivityMetrics, error) {
+	if days <= 0 {
+		days = activityMetricsDefaultDays
+	} else if days > activityMetricsMaxDays {
+		days = activityMetricsMaxDays
+	}
+	day := activityDate(date)
+
+	metrics := &model.ActivityMetrics{Date: day.Format(time.DateOnly)}
+	for _, c := range []struct {
+		dst  *int
+		span int
+	}{
+		{&metrics.DAU, 1},
+		{&metrics.WAU, 7},
+		{&metrics.MAU, 30},
+	} {
+		count, err := s.activityRepo.CountActiveUsers(ctx, model.DateRange{From: day.AddDate(0, 0, -(c.span - 1)), To: day})
+		if err != nil {
+			return nil, errors.Wrapf(err, "[Metrics] count active users failed (span=%d)", c.span)
+		}
+		*c.dst = count
+	}
+
+	// created_at 은 UTC 로 저장되므로 활동 시간대 자정을 UTC 로 변환해서 비교
+	dayStart := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, activityLocation)
+	newUsers, err := s.activityRepo.CountNewUsers(ctx, dayStart.UTC(), dayStart.AddDate(0, 0, 1).UTC())
+	if err != nil {
+		return nil, errors.Wrap(err, "[Metrics] count new users failed")
+	}
+	metrics.NewUsers = newUsers
+
+	daily, err := s.activityRepo.ListDailyActiveUsers(ctx, model.DateRange{From: day.AddDate(0, 0, -(days - 1)), To: day})
+	if err != nil {
+		return nil, errors.Wrap(err, "[Metrics] list daily active users failed")
+	}
+	metrics.Daily = daily
+	return metrics, nil
+}
+
+// ExportSection 개인정보 내보내기 아카이브에 로그인/활동 기록 포함
+func (s *ActivityService) ExportSection() ExportSection {
+	return ExportSection{
+		FileName: "login_activity.json",
+		Collect: func(ctx context.Context, userID int) (interface{}, error) {
+			user, err := s.userRepo.FindByID(ctx, userID)
+			if err != nil {
+				return nil, err
+			}
+			daily, err := s.activityRepo.ListUserActivity(ctx, userID)
+			if err != nil {
+				return nil, err
+			}
+			return map[string]interface{}{
+				"last_login_at": user.LastLoginAt,
+				"last_seen_at":  user.LastSeenAt,
+				"visits_count":  user.VisitsCount,
+				"daily":         daily,
+			}, nil
+		},
+	}
+}
+
+// activityDate 활동 시간대 기준 날짜 (DATE 컬럼에 넣기 위해 UTC 자정으로 표현)
+func activityDate(t time.Time) time.Time {
+	local := t.In(activityLocation)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
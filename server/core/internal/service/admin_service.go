@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"server/internal/apperror"
+	"server/internal/cache"
+	"server/internal/model"
+	"server/internal/repository"
+	"server/pkg/validator"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// 계정 상태 캐시 유지 시간 (조치한 인스턴스는 즉시 무효화, 다른 인스턴스는 최대 이 시간 후 반영)
+	accountStatusCacheTTL = 30 * time.Second
+	adminActionListLimit  = 100
+	// 개인정보 내보내기에 포함할 조치 이력 최대 수
+	adminActionExportLimit = 10000
+	adminReasonMaxLen      = 1000
+)
+
+// ErrSelfAdminAction 관리자가 자기 자신을 정지/차단/강등하려는 경우
+var ErrSelfAdminAction = apperror.BadRequest("SELF_ADMIN_ACTION", "자기 자신에게는 이 조치를 할 수 없습니다.")
+
+// AdminService 관리자 유저 관리 (정지/영구 차단/해제, 역할 변경, 내부 메모)
+// AuthMiddleware 의 계정 상태 확인(AccountStatus)도 담당
+type AdminService struct {
+	userRepo         repository.UserRepository
+	adminRepo        repository.AdminRepository
+	refreshTokenRepo repository.RefreshTokenRepository
+
+	statuses *cache.TTL[int, *model.AccountStatus]
+}
+
+func NewAdminService(
+	userRepo repository.UserRepository,
+	adminRepo repository.AdminRepository,
+	refreshTokenRepo repository.RefreshTokenRepository,
+) *AdminService {
+	return &AdminService{
+		userRepo:         userRepo,
+		adminRepo:        adminRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		statuses:         cache.NewTTL[int, *model.AccountStatus](accountStatusCacheTTL),
+	}
+}
+
+// AccountStatus 최신 역할/정지 상태 (짧게 캐시)
+func (s *AdminService) AccountStatus(ctx context.Context, userID int) (*model.AccountStatus, error) {
+	if status, ok := s.statuses.Get(userID); ok {
+		return status, nil
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "[AccountStatus] find user failed")
+	}
+	status := user.AccountStatus()
+	s.statuses.Set(userID, status)
+	return status, nil
+}
+
+// InvalidateAccountStatus 계정 상태가 바뀐 직후 호출 (이 인스턴스의 다음 요청부터 바로 반영)
+func (s *AdminService) InvalidateAccountStatus(userID int) {
+	s.statuses.Delete(userID)
+}
+
+// GetUser 관리자용 유저 상세 (탈퇴 유저 포함)
+func (s *AdminService) GetUser(ctx context.Context, userID int) (*model.User, error) {
+	return s.userRepo.FindByID(ctx, userID)
+}
+
+// Suspend until 까지 이용 정지 + 모든 세션 폐기
+func (s *AdminService) Suspend(ctx context.Context, adminID, userID int, until time.Time, reason string) (*model.User, error) {
+	var verrs validator.Errors
+	verrs.Add("reason", validateAdminReason(reason))
+	if !until.After(time.Now()) {
+		verrs.Add("until", "정지 종료 시각은 현재 이후여야 합니다")
+	}
+	if err := verrs.Err(); err != nil {
+		return nil, err
+	}
+	return s.setStatus(ctx, &model.AdminAction{
+		AdminID:      adminID,
+		TargetUserID: userID,
+		Action:       model.AdminActionSuspend,
+		Reason:       strings.TrimSpace(reason),
+		Details:      map[string]interface{}{"until": until},
+	}, model.UserStatusSuspended, &until)
+}
+
+// Ban 영구 차단 + 모든 세션 폐기
+func (s *AdminService) Ban(ctx context.Context, adminID, userID int, reason string) (*model.User, error) {
+	var verrs validator.Errors
+	verrs.Add("reason", validateAdminReason(reason))
+	if err := verrs.Err(); err != nil {
+		return nil, err
+	}
+	return s.setStatus(ctx, &model.AdminAction{
+		AdminID:      adminID,
+		TargetUserID: userID,
+		Action:       model.AdminActionBan,
+		Reason:       strings.TrimSpace(reason),
+	}, model.UserStatusBanned, nil)
+}
+
+// Reinstate 정지/차단 해제
+func (s *AdminService) Reinstate(ctx context.Context, adminID, userID int, reason string) (*model.User, error) {
+	var verrs validator.Errors
+	verrs.Add("reason", validateAdminReason(reason))
+	if err := verrs.Err(); err != nil {
+		return nil, err
+	}
+	return s.setStatus(ctx, &model.AdminAction{
+		AdminID:      adminID,
+		TargetUserID: userID,
+		Action:       model.AdminActionReinstate,
+		Reason:       strings.TrimSpace(reason),
+	}, model.UserStatusActive, nil)
+}
+
+func (s *AdminService) setStatus(ctx context.Context, action *model.AdminAction, status string, until *time.Time) (*model.User, error) {
+	if action.AdminID == action.TargetUserID {
+		return nil, ErrSelfAdminAction
+	}
+	prev, err := s.userRepo.FindByID(ctx, action.TargetUserID)
+	if err != nil {
+		return nil, errors.Wrap(err, "[setStatus] find target user failed")
+	}
+	action.Details = withDetail(action.Details, "previous_status", prev.Status)
+
+	if err := s.adminRepo.SetUserStatus(ctx, action, status, until); err != nil {
+		return nil, errors.Wrap(err, "[setStatus] set user status failed")
+	}
+	s.InvalidateAccountStatus(action.TargetUserID)
+
+	if status != model.UserStatusActive {
+		if err := s.refreshTokenRepo.DeleteAllByUserID(ctx, action.TargetUserID); err != nil {
+			return nil, errors.Wrap(err, "[setStatus] revoke sessions failed")
+		}
+	}
+
+	log.Ctx(ctx).Info().
+		Int("admin_id", action.AdminID).
+		Int("user_id", action.TargetUserID).
+		Str("action", action.Action).
+		Msg("[setStatus] admin action applied")
+	return s.userRepo.FindByID(ctx, action.TargetUserID)
+}
+
+// ChangeRole 역할 변경 (USER / ADMIN)
+func (s *AdminService) ChangeRole(ctx context.Context, adminID, userID int, role, reason string) (*model.User, error) {
+	var verrs validator.Errors
+	verrs.Add("reason", validateAdminReason(reason))
+	if role != model.RoleUser && role != model.RoleAdmin {
+		verrs.Add("role", "역할은 "+model.RoleUser+" 또는 "+model.RoleAdmin+" 이어야 합니다")
+	}
+	if err := verrs.Err(); err != nil {
+		return nil, err
+	}
+	if adminID == userID {
+		return nil, ErrSelfAdminAction
+	}
+
+	prev, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ChangeRole] find target user failed")
+	}
+	action := &model.AdminAction{
+		AdminID:      adminID,
+		TargetUserID: userID,
+		Action:       model.AdminActionChangeRole,
+		Reason:       strings.TrimSpace(reason),
+		Details:      map[string]interface{}{"previous_role": prev.Role, "role": role},
+	}
+	if err := s.adminRepo.SetUserRole(ctx, action, role); err != nil {
+		return nil, errors.Wrap(err, "[ChangeRole] set user role failed")
+	}
+	s.InvalidateAccountStatus(userID)
+	return s.userRepo.FindByID(ctx, userID)
+}
+
+// AddNote 내부 메모 추가
+func (s *AdminService) AddNote(ctx context.Context, adminID, userID int, note string) (*model.AdminNote, error) {
+	var verrs validator.Errors
+	verrs.Add("note", validateAdminReason(note))
+	if err := verrs.Err(); err != nil {
+		return nil, err
+	}
+	if _, err := s.userRepo.FindByID(ctx, userID); err != nil {
+		return nil, errors.Wrap(err, "[AddNote] find target user failed")
+	}
+
+	n := &model.AdminNote{UserID: userID, AdminID: adminID, Note: strings.TrimSpace(note)}
+	action := &model.AdminAction{
+		AdminID:      adminID,
+		TargetUserID: userID,
+		Action:       model.AdminActionAddNote,
+		Reason:       "internal note",
+	}
+	if err := s.adminRepo.CreateNote(ctx, action, n); err != nil {
+		return nil, errors.Wrap(err, "[AddNote] create note failed")
+	}
+	return n, nil
+}
+
+func (s *AdminService) ListNotes(ctx context.Context, userID int) ([]model.AdminNote, error) {
+	return s.adminRepo.ListNotes(ctx, userID)
+}
+
+func (s *AdminService) ListActions(ctx context.Context, userID int) ([]model.AdminAction, error) {
+	return s.adminRepo.ListActions(ctx, userID, adminActionListLimit)
+}
+
+// ExportSection 개인정보 내보내기 아카이브에 본인이 받은 관리자 조치 이력 포함
+// 조치한 관리자 ID 와 내부 메모는 제외
+func (s *AdminService) ExportSection() ExportSection {
+	return ExportSection{
+		FileName: "admin_actions.json",
+		Collect: func(ctx context.Context, userID int) (interface{}, error) {
+			actions, err := s.adminRepo.ListActions(ctx, userID, adminActionExportLimit)
+			if err != nil {
+				return nil, err
+			}
+			results := make([]map[string]interface{}, 0, len(actions))
+			for _, a := range actions {
+				results = append(results, map[string]interface{}{
+					"action":     a.Action,
+					"reason":     a.Reason,
+					"details":    a.Details,
+					"created_at": a.CreatedAt,
+				})
+			}
+			return results, nil
+		},
+	}
+}
+
+func validateAdminReason(reason string) string {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return "사유를 입력해주세요"
+	}
+	if len([]rune(reason)) > adminReasonMaxLen {
+		return "1000자 이하로 입력해주세요"
+	}
+	return ""
+}
+
+func withDetail(details map[string]interface{}, key string, value interface{}) map[string]interface{} {
+	if details == nil {
+		details = make(map[string]interface{})
+	}
+	details[key] = value
+	return details
+}
@@ -7,38 +7,62 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"server/internal/apperror"
 	"server/internal/config"
+	"server/internal/cookie"
 	"server/internal/model"
 	"server/internal/repository"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 )
 
+// ErrAccountPendingDeletion 탈퇴 유예 기간 중인 계정으로 로그인 시도 (복구 토큰 쿠키가 설정된 상태로 반환)
+var ErrAccountPendingDeletion = apperror.Forbidden("ACCOUNT_PENDING_DELETION", "탈퇴 유예 기간 중인 계정입니다.")
+
+// ErrAccountBlocked 관리자가 정지/영구 차단한 계정으로 로그인 시도 (사유는 user.AccountStatus() 로 확인)
+var ErrAccountBlocked = apperror.Forbidden("ACCOUNT_BLOCKED", "이용이 제한된 계정입니다.")
+
 type AuthService struct {
 	cfg              *config.AppConfig
+	cookies          *cookie.Policy
 	httpClient       *http.Client
 	oAuthSecrets     *config.OAuthSecrets
 	userRepo         repository.UserRepository
 	refreshTokenRepo repository.RefreshTokenRepository
+	identityRepo     repository.UserIdentityRepository
 	jwtManager       *JWTManager
+	activitySvc      *ActivityService
+	adminSvc         *AdminService // 계정 복구 시 상태 캐시 무효화
+	notifier         Notifier
 }
 
 func NewAuthService(
 	cfg *config.AppConfig,
+	cookies *cookie.Policy,
 	oAuthSecrets *config.OAuthSecrets,
 	userRepo repository.UserRepository,
 	refreshTokenRepo repository.RefreshTokenRepository,
+	identityRepo repository.UserIdentityRepository,
 	jwtManager *JWTManager,
+	activitySvc *ActivityService,
+	adminSvc *AdminService,
+	notifier Notifier,
 ) *AuthService {
 	return &AuthService{
 		cfg:              cfg,
+		cookies:          cookies,
 		httpClient:       &http.Client{Timeout: 10 * time.Second},
 		oAuthSecrets:     oAuthSecrets,
 		userRepo:         userRepo,
 		refreshTokenRepo: refreshTokenRepo,
+		identityRepo:     identityRepo,
 		jwtManager:       jwtManager,
+		activitySvc:      activitySvc,
+		adminSvc:         adminSvc,
+		notifier:         notifier,
 	}
 }
 
@@ -121,8 +145,9 @@ func (s *AuthService) ProcessGoogleCallback(ctx context.Context, code string) (*
 	}
 
 	var tokenResp struct {
-		AccessToken string `json:"access_token"`
-		IdToken     string `json:"id_token,omitempty"`
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token,omitempty"`
+		IdToken      string `json:"id_token,omitempty"`
 	}
 	if err := json.NewDecoder(res.Body).Decode(&tokenResp); err != nil {
 		return nil, errors.Wrap(err, "[ProcessGoogleCallback] decode tokenResp failed")
@@ -163,7 +188,13 @@ func (s *AuthService) ProcessGoogleCallback(ctx context.Context, code string) (*
 		nickname = "GoogleUser"
 	}
 
-	return s.upsertUser(ctx, "google", googleUser.Email, nickname, googleUser.Name, googleUser.Picture)
+	identity := &model.UserIdentity{
+		Provider:       "google",
+		ProviderUserID: googleUser.ID,
+		AccessToken:    tokenResp.AccessToken,
+		RefreshToken:   tokenResp.RefreshToken,
+	}
+	return s.upsertUser(ctx, identity, googleUser.Email, nickname, googleUser.Name, googleUser.Picture)
 }
 
 // -----------------------------------------------
@@ -201,8 +232,9 @@ func (s *AuthService) ProcessKakaoCallback(ctx context.Context, code string) (*m
 	}
 
 	var tokenResp struct {
-		AccessToken string `json:"access_token"`
-		IdToken     string `json:"id_token,omitempty"`
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token,omitempty"`
+		IdToken      string `json:"id_token,omitempty"`
 	}
 	if err := json.NewDecoder(res.Body).Decode(&tokenResp); err != nil {
 		return nil, errors.Wrap(err, "[ProcessKakaoCallback] decode tokenResp failed")
@@ -244,7 +276,13 @@ func (s *AuthService) ProcessKakaoCallback(ctx context.Context, code string) (*m
 	name := kakaoResp.KakaoAccount.Profile.Nickname
 	profileImg := kakaoResp.KakaoAccount.Profile.ProfileImg
 
-	return s.upsertUser(ctx, "kakao", email, name, name, profileImg)
+	identity := &model.UserIdentity{
+		Provider:       "kakao",
+		ProviderUserID: strconv.FormatInt(kakaoResp.Id, 10),
+		AccessToken:    tokenResp.AccessToken,
+		RefreshToken:   tokenResp.RefreshToken,
+	}
+	return s.upsertUser(ctx, identity, email, name, name, profileImg)
 }
 
 // -----------------------------------------------
@@ -279,7 +317,8 @@ func (s *AuthService) ProcessNaverCallback(ctx context.Context, code, state stri
 	}
 
 	var tokenResp struct {
-		AccessToken string `json:"access_token"`
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
 	}
 	if err := json.NewDecoder(res.Body).Decode(&tokenResp); err != nil {
 		return nil, errors.Wrap(err, "[ProcessNaverCallback] decode tokenResp failed")
@@ -325,18 +364,38 @@ func (s *AuthService) ProcessNaverCallback(ctx context.Context, code, state stri
 	}
 	profileImg := naverResp.Response.ProfileImage
 
-	return s.upsertUser(ctx, "naver", email, name, name, profileImg)
+	identity := &model.UserIdentity{
+		Provider:       "naver",
+		ProviderUserID: naverResp.Response.Id,
+		AccessToken:    tokenResp.AccessToken,
+		RefreshToken:   tokenResp.RefreshToken,
+	}
+	return s.upsertUser(ctx, identity, email, name, name, profileImg)
 }
 
 // -----------------------------------------------
 // 로그인 성공시 쿠키 세팅
 // -----------------------------------------------
 func (s *AuthService) LoginUserAndSetCookies(w http.ResponseWriter, user *model.User) error {
-	user.VisitsCount++
-	if err := s.userRepo.UpdateUser(context.Background(), user); err != nil {
-		return errors.Wrap(err, "[LoginUserAndSetCookies] update user visits_count failed")
+	// 탈퇴 유예 기간 중이면 로그인 대신 복구 토큰만 발급 → 프론트에서 복구 여부를 물어봄
+	if user.IsPendingDeletion() {
+		restoreToken, err := s.jwtManager.GenerateRestoreToken(user)
+		if err != nil {
+			return errors.Wrap(err, "[LoginUserAndSetCookies] generate restore token failed")
+		}
+		s.cookies.Set(w, cookie.RestoreToken, restoreToken, s.jwtManager.RestoreTokenTTL)
+		return ErrAccountPendingDeletion
 	}
 
+	if code := user.AccountStatus().BlockCode(time.Now()); code != "" {
+		return errors.Wrapf(ErrAccountBlocked, "[LoginUserAndSetCookies] userID=%d code=%s", user.ID, code)
+	}
+
+	if err := s.activitySvc.RecordLogin(context.Background(), user.ID); err != nil {
+		return errors.Wrap(err, "[LoginUserAndSetCookies] record login failed")
+	}
+	user.VisitsCount++
+
 	refreshTokenStr, err := s.jwtManager.GenerateRefreshToken(user)
 	if err != nil {
 		return errors.Wrap(err, "[LoginUserAndSetCookies] generate refresh token failed")
@@ -352,55 +411,94 @@ func (s *AuthService) LoginUserAndSetCookies(w http.ResponseWriter, user *model.
 		return errors.Wrap(err, "[LoginUserAndSetCookies] createOrUpdate refresh token failed")
 	}
 
-	accessTokenStr, err := s.jwtManager.GenerateAccessToken(user)
+	accessTokenStr, err := s.jwtManager.GenerateAccessToken(user, rt.ID)
 	if err != nil {
 		return errors.Wrap(err, "[LoginUserAndSetCookies] generate access token failed")
 	}
 
-	// 쿠키 설정 (domain, secure 등은 config 값 사용)
-	s.setCookie(w, "access_token", accessTokenStr, s.jwtManager.AccessTokenTTL)
-	s.setCookie(w, "refresh_token", refreshTokenStr, s.jwtManager.RefreshTokenTTL)
+	// 쿠키 설정 (prefix, domain, secure 등은 cookie 설정 값 사용)
+	s.cookies.Set(w, cookie.AccessToken, accessTokenStr, s.jwtManager.AccessTokenTTL)
+	s.cookies.Set(w, cookie.RefreshToken, refreshTokenStr, s.jwtManager.RefreshTokenTTL)
 	return nil
 }
 
-func (s *AuthService) setCookie(w http.ResponseWriter, name, value string, ttl time.Duration) {
-	domain := s.cfg.CookieDomain
-	http.SetCookie(w, &http.Cookie{
-		Name:     name,
-		Value:    value,
-		Path:     "/",
-		Domain:   domain,
-		Secure:   (domain != "localhost"), // localhost 아니면 https 적용
-		HttpOnly: true,
-		SameSite: http.SameSiteLaxMode,
-		Expires:  time.Now().Add(ttl),
-		MaxAge:   int(ttl.Seconds()),
+// RestoreAccountAndLogin 복구 토큰을 검증해 탈퇴 유예 중인 계정을 복구하고 로그인 쿠키를 설정
+func (s *AuthService) RestoreAccountAndLogin(ctx context.Context, w http.ResponseWriter, restoreToken string) (*model.User, error) {
+	token, err := s.jwtManager.VerifyToken(restoreToken)
+	if err != nil {
+		return nil, errors.Wrap(err, "[RestoreAccountAndLogin] verify restore token failed")
+	}
+	claims, ok := token.Claims.(MapClaimsWithSubID)
+	if !ok || claims.GetUserID() == 0 {
+		return nil, errors.New("[RestoreAccountAndLogin] invalid restore token claims")
+	}
+	if !hasScope(claims.GetScopes(), RestoreTokenScope) {
+		return nil, errors.New("[RestoreAccountAndLogin] token is not a restore token")
+	}
+
+	if err := s.userRepo.RestoreUser(ctx, claims.GetUserID()); err != nil {
+		return nil, errors.Wrap(err, "[RestoreAccountAndLogin] restore user failed")
+	}
+	// 캐시된 탈퇴 상태 때문에 복구 직후 요청이 401 이 되지 않도록
+	s.adminSvc.InvalidateAccountStatus(claims.GetUserID())
+	user, err := s.userRepo.FindByID(ctx, claims.GetUserID())
+	if err != nil {
+		return nil, errors.Wrap(err, "[RestoreAccountAndLogin] find restored user failed")
+	}
+
+	s.cookies.Clear(w, cookie.RestoreToken)
+	if err := s.LoginUserAndSetCookies(w, user); err != nil {
+		return nil, errors.Wrap(err, "[RestoreAccountAndLogin] login after restore failed")
+	}
+	NotifyQuietly(ctx, s.notifier, model.NotificationInput{
+		UserID:  user.ID,
+		Type:    model.NotificationTypeSecurity,
+		Payload: map[string]interface{}{"event": model.SecurityEventAccountRestored},
 	})
+	return user, nil
+}
+
+func hasScope(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
 }
 
 // -----------------------------------------------
-// 유저가 없으면 새로 생성, 있으면 그대로
+// 유저가 없으면 새로 생성, 있으면 그대로 + OAuth 계정 연결 정보 갱신
 // -----------------------------------------------
 func (s *AuthService) upsertUser(
 	ctx context.Context,
-	provider, email, nickname, name, profileImg string,
+	identity *model.UserIdentity,
+	email, nickname, name, profileImg string,
 ) (*model.User, error) {
 	u, err := s.userRepo.FindByEmail(ctx, email)
 	if err != nil {
 		newUser := &model.User{
-			OauthProvider: provider,
+			OauthProvider: identity.Provider,
 			Email:         email,
 			Nickname:      nickname,
 			Name:          name,
 			ProfileImage:  profileImg,
-			Role:          "USER",
+			Role:          model.RoleUser,
 			VisitsCount:   1,
 		}
 		created, createErr := s.userRepo.CreateUser(ctx, newUser)
 		if createErr != nil {
 			return nil, createErr
 		}
-		return created, nil
+		u = created
+	}
+
+	// 탈퇴 시 unlink API 호출을 위해 제공자 계정 ID / 토큰 보관
+	if identity.ProviderUserID != "" {
+		identity.UserID = u.ID
+		if err := s.identityRepo.Upsert(ctx, identity); err != nil {
+			return nil, errors.Wrap(err, "[upsertUser] upsert user identity failed")
+		}
 	}
 	return u, nil
 }
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"server/internal/apperror"
+	"server/internal/config"
+	"server/internal/model"
+	"server/internal/repository"
+	"server/internal/storage"
+	"server/pkg/imaging"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// 대표 이미지(profile_image) 크기가 첫 번째
+	avatarMainSize     = 512
+	avatarJPEGQuality  = 85
+	avatarFetchTimeout = 10 * time.Second
+)
+
+// 생성하는 썸네일 크기 (px, 정사각형)
+var avatarSizes = []int{avatarMainSize, 256, 64}
+
+var (
+	// ErrAvatarTooLarge avatar.max_upload_bytes 초과
+	ErrAvatarTooLarge = apperror.New(apperror.TypeTooLarge, "AVATAR_TOO_LARGE", "프로필 이미지 파일이 너무 큽니다.")
+	// ErrUnsupportedAvatar JPEG/PNG/GIF 가 아니거나 손상된 이미지, 픽셀 수 초과
+	ErrUnsupportedAvatar = apperror.New(apperror.TypeUnsupportedMedia, "UNSUPPORTED_AVATAR", "JPEG, PNG, GIF 이미지만 업로드할 수 있습니다.")
+)
+
+// AvatarService 프로필 이미지 업로드: 내용 기반 타입 판별 → 재인코딩(EXIF 제거) → 크기별 썸네일 저장
+type AvatarService struct {
+	cfg        *config.AppConfig
+	userRepo   repository.UserRepository
+	store      storage.BlobStore
+	httpClient *http.Client
+}
+
+func NewAvatarService(cfg *config.AppConfig, userRepo repository.UserRepository, store storage.BlobStore) *AvatarService {
+	return &AvatarService{
+		cfg:        cfg,
+		userRepo:   userRepo,
+		store:      store,
+		httpClient: &http.Client{Timeout: avatarFetchTimeout},
+	}
+}
+
+// ReadLimited 최대 크기 + 1 바이트까지만 읽어 초과 여부 판단
+func (s *AvatarService) ReadLimited(r io.Reader) ([]byte, error) {
+	limit := s.cfg.Avatar.MaxUploadBytes
+	data, err := io.ReadAll(io.LimitReader(r, limit+1))
+	if err != nil {
+		return nil, errors.Wrap(err, "[ReadLimited] read failed")
+	}
+	if int64(len(data)) > limit {
+		return nil, ErrAvatarTooLarge
+	}
+	return data, nil
+}
+
+// Upload 업로드한 이미지로 프로필 이미지 교체 (이전 이미지 파일은 삭제)
+func (s *AvatarService) Upload(ctx context.Context, userID int, data []byte) (*model.User, error) {
+	return s.replace(ctx, userID, data)
+}
+
+// ImportProviderAvatar 만료될 수 있는 OAuth 제공자 이미지 URL 을 내려받아 저장소로 복사
+func (s *AvatarService) ImportProviderAvatar(ctx context.Context, user *model.User) (*model.User, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, user.ProfileImage, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ImportProviderAvatar] new request failed")
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ImportProviderAvatar] fetch failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("[ImportProviderAvatar] unexpected status %d", resp.StatusCode)
+	}
+
+	data, err := s.ReadLimited(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return s.replace(ctx, user.ID, data)
+}
+
+// URLs 크기별 썸네일 URL (저장소 이미지가 아니면 nil)
+func (s *AvatarService) URLs(user *model.User) map[string]string {
+	if user.AvatarKey == "" {
+		return nil
+	}
+	urls := make(map[string]string, len(avatarSizes))
+	for _, size := range avatarSizes {
+		urls[fmt.Sprintf("%d", size)] = s.store.URL(avatarObjectKey(user.AvatarKey, size))
+	}
+	return urls
+}
+
+// DeleteObjects 키 접두사에 해당하는 모든 썸네일 삭제 (탈퇴 익명화, 이미지 교체 시)
+func (s *AvatarService) DeleteObjects(ctx context.Context, avatarKey string) error {
+	if avatarKey == "" {
+		return nil
+	}
+	for _, size := range avatarSizes {
+		if err := s.store.Delete(ctx, avatarObjectKey(avatarKey, size)); err != nil {
+			return errors.Wrapf(err, "[DeleteObjects] delete failed (key=%s, size=%d)", avatarKey, size)
+		}
+	}
+	return nil
+}
+
+func (s *AvatarService) replace(ctx context.Context, userID int, data []byte) (*model.User, error) {
+	img, err := imaging.Decode(data, s.cfg.Avatar.MaxPixels)
+	if err != nil {
+		return nil, errors.Wrap(ErrUnsupportedAvatar, err.Error())
+	}
+
+	prev, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "[replace] find user failed")
+	}
+
+	suffix, err := randomHex(8)
+	if err != nil {
+		return nil, err
+	}
+	avatarKey := fmt.Sprintf("avatars/%d/%s", userID, suffix)
+
+	for _, size := range avatarSizes {
+		var buf bytes.Buffer
+		if err := imaging.EncodeJPEG(&buf, imaging.SquareThumbnail(img, size), avatarJPEGQuality); err != nil {
+			s.cleanup(avatarKey)
+			return nil, errors.Wrapf(err, "[replace] encode thumbnail failed (size=%d)", size)
+		}
+		if err := s.store.Put(ctx, avatarObjectKey(avatarKey, size), buf.Bytes(), "image/jpeg"); err != nil {
+			s.cleanup(avatarKey)
+			return nil, errors.Wrapf(err, "[replace] store thumbnail failed (size=%d)", size)
+		}
+	}
+
+	profileImage := s.store.URL(avatarObjectKey(avatarKey, avatarMainSize))
+	user, err := s.userRepo.UpdateUser(ctx, userID, model.UserUpdate{
+		ProfileImage: &profileImage,
+		AvatarKey:    &avatarKey,
+	})
+	if err != nil {
+		s.cleanup(avatarKey)
+		return nil, errors.Wrap(err, "[replace] update user failed")
+	}
+
+	if err := s.DeleteObjects(ctx, prev.AvatarKey); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Int("user_id", userID).Msg("[replace] delete previous avatar failed")
+	}
+	return user, nil
+}
+
+// cleanup 실패한 업로드의 남은 파일 정리 (요청 ctx 가 취소됐을 수 있으므로 별도 ctx)
+func (s *AvatarService) cleanup(avatarKey string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.DeleteObjects(ctx, avatarKey); err != nil {
+		log.Warn().Err(err).Str("avatar_key", avatarKey).Msg("[cleanup] delete partial avatar failed")
+	}
+}
+
+func avatarObjectKey(avatarKey string, size int) string {
+	return fmt.Sprintf("%s/%d.jpg", avatarKey, size)
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"server/internal/apperror"
+	"server/internal/model"
+	"server/internal/pagination"
+	"server/internal/repository"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrBlocked 어느 한쪽이 상대를 차단한 상태에서 팔로우/댓글 등 상호작용을 시도한 경우
+	ErrBlocked = apperror.Forbidden("USER_BLOCKED", "차단 관계인 유저와는 상호작용할 수 없습니다.")
+	// ErrSelfRestriction 자기 자신을 차단/뮤트하려는 경우
+	ErrSelfRestriction = apperror.BadRequest("SELF_RESTRICTION", "자기 자신을 차단하거나 뮤트할 수 없습니다.")
+)
+
+// BlockService 차단/뮤트 관리와 상호작용 정책 확인
+// 차단: 서로의 콘텐츠를 숨기고 팔로우 관계를 끊으며 이후 상호작용을 막음
+// 뮤트: 피드에서만 숨김 (repository.NotMutedCondition)
+type BlockService struct {
+	userRepo  repository.UserRepository
+	blockRepo repository.BlockRepository
+}
+
+func NewBlockService(userRepo repository.UserRepository, blockRepo repository.BlockRepository) *BlockService {
+	return &BlockService{
+		userRepo:  userRepo,
+		blockRepo: blockRepo,
+	}
+}
+
+// CheckInteraction actorID 가 targetID 에게 상호작용(팔로우, 댓글 등)할 수 있는지 확인하는 정책 함수
+// 어느 방향이든 차단이 있으면 ErrBlocked
+func (s *BlockService) CheckInteraction(ctx context.Context, actorID, targetID int) error {
+	if actorID == 0 || actorID == targetID {
+		return nil
+	}
+	blocked, err := s.blockRepo.IsBlockedEither(ctx, actorID, targetID)
+	if err != nil {
+		return errors.Wrap(err, "[CheckInteraction] check block failed")
+	}
+	if blocked {
+		return errors.Wrapf(ErrBlocked, "[CheckInteraction] actor=%d target=%d", actorID, targetID)
+	}
+	return nil
+}
+
+// Block 차단하면 양방향 팔로우 관계(승인 대기 요청 포함)도 함께 삭제
+func (s *BlockService) Block(ctx context.Context, blockerID, blockedID int) error {
+	if err := s.ensureTarget(ctx, blockerID, blockedID); err != nil {
+		return errors.Wrap(err, "[Block] check target failed")
+	}
+	return s.blockRepo.Block(ctx, blockerID, blockedID)
+}
+
+func (s *BlockService) Unblock(ctx context.Context, blockerID, blockedID int) error {
+	return s.blockRepo.Unblock(ctx, blockerID, blockedID)
+}
+
+func (s *BlockService) Mute(ctx context.Context, muterID, mutedID int) error {
+	if err := s.ensureTarget(ctx, muterID, mutedID); err != nil {
+		return errors.Wrap(err, "[Mute] check target failed")
+	}
+	return s.blockRepo.Mute(ctx, muterID, mutedID)
+}
+
+func (s *BlockService) Unmute(ctx context.Context, muterID, mutedID int) error {
+	return s.blockRepo.Unmute(ctx, muterID, mutedID)
+}
+
+func (s *BlockService) ListBlocked(ctx context.Context, userID int, page pagination.Request) (pagination.Page[model.RestrictedUser], error) {
+	users, err := s.blockRepo.ListBlocked(ctx, userID, page)
+	if err != nil {
+		return pagination.Page[model.RestrictedUser]{}, errors.Wrap(err, "[ListBlocked] list blocked users failed")
+	}
+	return pagination.NewPage(users, page, repository.RestrictedUserSortKey), nil
+}
+
+func (s *BlockService) ListMuted(ctx context.Context, userID int, page pagination.Request) (pagination.Page[model.RestrictedUser], error) {
+	users, err := s.blockRepo.ListMuted(ctx, userID, page)
+	if err != nil {
+		return pagination.Page[model.RestrictedUser]{}, errors.Wrap(err, "[ListMuted] list muted users failed")
+	}
+	return pagination.NewPage(users, page, repository.RestrictedUserSortKey), nil
+}
+
+// restrictionExportSort 내보내기는 오래된 순으로 전체 수집
+var restrictionExportSort = pagination.Sort{Name: "created_at", Field: repository.RestrictedUserSorts["created_at"]}
+
+// ExportSection 개인정보 내보내기 아카이브에 차단/뮤트 목록 포함
+func (s *BlockService) ExportSection() ExportSection {
+	return ExportSection{
+		FileName: "blocks.json",
+		Collect: func(ctx context.Context, userID int) (interface{}, error) {
+			blocked, err := pagination.CollectAll(restrictionExportSort, repository.RestrictedUserSortKey, func(page pagination.Request) ([]model.RestrictedUser, error) {
+				return s.blockRepo.ListBlocked(ctx, userID, page)
+			})
+			if err != nil {
+				return nil, err
+			}
+			muted, err := pagination.CollectAll(restrictionExportSort, repository.RestrictedUserSortKey, func(page pagination.Request) ([]model.RestrictedUser, error) {
+				return s.blockRepo.ListMuted(ctx, userID, page)
+			})
+			if err != nil {
+				return nil, err
+			}
+			return map[string]interface{}{
+				"blocked": blocked,
+				"muted":   muted,
+			}, nil
+		},
+	}
+}
+
+// ensureTarget 자기 자신이 아니고 탈퇴하지 않은 유저인지 확인
+func (s *BlockService) ensureTarget(ctx context.Context, userID, targetID int) error {
+	if userID == targetID {
+		return ErrSelfRestriction
+	}
+	target, err := s.userRepo.FindByID(ctx, targetID)
+	if err != nil {
+		return err
+	}
+	if target.DeletedAt != nil {
+		return errors.Wrapf(repository.ErrNotFound, "[ensureTarget] user %d is deleted", targetID)
+	}
+	return nil
+}
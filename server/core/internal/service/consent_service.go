@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"server/internal/apperror"
+	"server/internal/cache"
+	"server/internal/model"
+	"server/internal/repository"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// 필수 약관에 모두 동의한 유저는 이 시간 동안 DB 재조회 없이 통과 (AuthMiddleware 매 요청 조회 방지)
+	consentCacheTTL = 5 * time.Minute
+)
+
+// ErrInvalidConsentDocument 현재 시행 중이 아닌 문서(구버전, 존재하지 않는 ID)에 동의하려는 경우
+var ErrInvalidConsentDocument = apperror.BadRequest("INVALID_CONSENT_DOCUMENT", "현재 시행 중인 약관 문서가 아닙니다.")
+
+// ConsentService 약관/개인정보 처리방침 버전별 동의 및 마케팅 수신 동의 관리
+type ConsentService struct {
+	consentRepo repository.ConsentRepository
+
+	// 필수 동의 완료가 확인된 userID
+	satisfied *cache.TTL[int, bool]
+}
+
+func NewConsentService(consentRepo repository.ConsentRepository) *ConsentService {
+	return &ConsentService{
+		consentRepo: consentRepo,
+		satisfied:   cache.NewTTL[int, bool](consentCacheTTL),
+	}
+}
+
+func (s *ConsentService) ListCurrentDocuments(ctx context.Context) ([]model.LegalDocument, error) {
+	return s.consentRepo.ListCurrentDocuments(ctx)
+}
+
+// PendingMandatoryDocuments 아직 동의하지 않은 필수 문서 (없으면 빈 슬라이스)
+func (s *ConsentService) PendingMandatoryDocuments(ctx context.Context, userID int) ([]model.LegalDocument, error) {
+	if _, ok := s.satisfied.Get(userID); ok {
+		return nil, nil
+	}
+
+	pending, err := s.consentRepo.ListPendingMandatory(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "[PendingMandatoryDocuments] list pending failed")
+	}
+	if len(pending) == 0 {
+		s.satisfied.Set(userID, true)
+	}
+	return pending, nil
+}
+
+// Accept 현재 시행 중인 문서들에 대한 동의 기록
+func (s *ConsentService) Accept(ctx context.Context, userID int, documentIDs []int, ipAddress, userAgent string) error {
+	current, err := s.consentRepo.ListCurrentDocuments(ctx)
+	if err != nil {
+		return errors.Wrap(err, "[Accept] list current documents failed")
+	}
+	currentIDs := make(map[int]bool, len(current))
+	for _, d := range current {
+		currentIDs[d.ID] = true
+	}
+	for _, id := range documentIDs {
+		if !currentIDs[id] {
+			return errors.Wrapf(ErrInvalidConsentDocument, "[Accept] documentID=%d", id)
+		}
+	}
+
+	if err := s.consentRepo.AcceptDocuments(ctx, userID, documentIDs, ipAddress, userAgent); err != nil {
+		return errors.Wrap(err, "[Accept] accept documents failed")
+	}
+	s.satisfied.Delete(userID)
+	return nil
+}
+
+func (s *ConsentService) ListUserConsents(ctx context.Context, userID int) ([]model.UserConsent, error) {
+	return s.consentRepo.ListUserConsents(ctx, userID)
+}
+
+// SetMarketingConsent 마케팅 수신 동의/철회 (이력으로 누적 저장)
+func (s *ConsentService) SetMarketingConsent(ctx context.Context, userID int, agreed bool, ipAddress, userAgent string) (*model.MarketingConsent, error) {
+	current, err := s.consentRepo.ListCurrentDocuments(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "[SetMarketingConsent] list current documents failed")
+	}
+
+	consent := &model.MarketingConsent{
+		UserID:    userID,
+		Agreed:    agreed,
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+	}
+	for _, d := range current {
+		if d.DocType == model.LegalDocMarketing {
+			docID := d.ID
+			consent.DocumentID = &docID
+			break
+		}
+	}
+
+	if err := s.consentRepo.RecordMarketingConsent(ctx, consent); err != nil {
+		return nil, errors.Wrap(err, "[SetMarketingConsent] record failed")
+	}
+	return consent, nil
+}
+
+func (s *ConsentService) GetMarketingConsent(ctx context.Context, userID int) (*model.MarketingConsent, error) {
+	return s.consentRepo.FindLatestMarketingConsent(ctx, userID)
+}
+
+// ExportSection 개인정보 내보내기 아카이브에 동의 이력 포함
+func (s *ConsentService) ExportSection() ExportSection {
+	return ExportSection{
+		FileName: "consents.json",
+		Collect: func(ctx context.Context, userID int) (interface{}, error) {
+			consents, err := s.consentRepo.ListUserConsents(ctx, userID)
+			if err != nil {
+				return nil, err
+			}
+			marketing, err := s.consentRepo.ListMarketingConsents(ctx, userID)
+			if err != nil {
+				return nil, err
+			}
+			return map[string]interface{}{
+				"legal_documents": consents,
+				"marketing":       marketing,
+			}, nil
+		},
+	}
+}
@@ -0,0 +1,364 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"server/internal/apperror"
+	"server/internal/config"
+	"server/internal/job"
+	"server/internal/model"
+	"server/internal/repository"
+	"server/internal/storage"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// 재시작/정리 주기마다 다시 큐에 넣을 미완료 요청 최대 수
+	dataExportResumeLimit = 100
+	// 생성 작업 점유 기한 (job 타임아웃보다 길어야 실행 중인 작업을 다른 인스턴스가 가져가지 않음)
+	dataExportLease = 30 * time.Minute
+	// 만료 파일 정리 주기 / 1회 처리량
+	dataExportCleanupInterval = time.Hour
+	dataExportCleanupBatch    = 100
+)
+
+// ErrDataExportBusy 작업 큐가 가득 차 요청을 접수하지 못함 (요청은 FAILED 로 남기므로 바로 다시 요청 가능)
+var ErrDataExportBusy = apperror.New(apperror.TypeUnavailable, "DATA_EXPORT_BUSY", "내보내기 요청이 많아 지금은 접수할 수 없습니다. 잠시 후 다시 시도해주세요.")
+
+// ErrDataExportUnavailable 아직 생성 중이거나 다운로드 기한이 지났거나 아카이브가 정리된 요청
+var ErrDataExportUnavailable = apperror.New(apperror.TypeGone, "EXPORT_UNAVAILABLE", "다운로드할 수 없는 내보내기 요청입니다.")
+
+// ExportSection 내보내기 아카이브에 JSON 파일 1개로 들어갈 데이터
+// 새 기능이 유저 데이터를 저장하면 RegisterSection 으로 자신의 섹션을 추가
+type ExportSection struct {
+	FileName string // 예) "profile.json"
+	Collect  func(ctx context.Context, userID int) (interface{}, error)
+}
+
+// DataExportService 개인정보 내보내기(takeout): 요청 접수 → 백그라운드 ZIP 생성 → 기한부 다운로드
+// 아카이브는 BlobStore 에 저장하므로 생성한 인스턴스와 다운로드/정리하는 인스턴스가 달라도 됨
+type DataExportService struct {
+	cfg        *config.AppConfig
+	exportRepo repository.DataExportRepository
+	queue      *job.Queue
+	notifier   Notifier
+	store      storage.BlobStore
+	sections   []ExportSection
+
+	mu     sync.Mutex
+	queued map[int]bool // 이 인스턴스 대기열에 있는 요청 (재개 시 중복 등록 방지)
+}
+
+func NewDataExportService(
+	cfg *config.AppConfig,
+	exportRepo repository.DataExportRepository,
+	userRepo repository.UserRepository,
+	identityRepo repository.UserIdentityRepository,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	queue *job.Queue,
+	notifier Notifier,
+	store storage.BlobStore,
+) *DataExportService {
+	s := &DataExportService{
+		cfg:        cfg,
+		exportRepo: exportRepo,
+		queue:      queue,
+		notifier:   notifier,
+		store:      store,
+		queued:     make(map[int]bool),
+	}
+
+	s.RegisterSection(ExportSection{
+		FileName: "profile.json",
+		Collect: func(ctx context.Context, userID int) (interface{}, error) {
+			return userRepo.FindByID(ctx, userID)
+		},
+	})
+	s.RegisterSection(ExportSection{
+		FileName: "identities.json",
+		Collect: func(ctx context.Context, userID int) (interface{}, error) {
+			return identityRepo.ListByUserID(ctx, userID)
+		},
+	})
+	s.RegisterSection(ExportSection{
+		FileName: "sessions.json",
+		Collect: func(ctx context.Context, userID int) (interface{}, error) {
+			tokens, err := refreshTokenRepo.ListByUserID(ctx, userID)
+			if err != nil {
+				return nil, err
+			}
+			// 토큰 값 자체는 내보내지 않음
+			sessions := make([]map[string]interface{}, 0, len(tokens))
+			for _, t := range tokens {
+				sessions = append(sessions, map[string]interface{}{
+					"id":         t.ID,
+					"created_at": t.CreatedAt,
+					"expired_at": t.ExpiredAt,
+				})
+			}
+			return sessions, nil
+		},
+	})
+	return s
+}
+
+// RegisterSection 아카이브에 포함할 섹션 추가
+func (s *DataExportService) RegisterSection(section ExportSection) {
+	s.sections = append(s.sections, section)
+}
+
+// RequestExport 내보내기 요청 접수 후 백그라운드 작업 등록 (이미 진행 중인 요청이 있으면 그대로 반환)
+func (s *DataExportService) RequestExport(ctx context.Context, userID int) (*model.DataExport, error) {
+	inProgress, err := s.exportRepo.FindInProgressByUserID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "[RequestExport] find in-progress export failed")
+	}
+	if inProgress != nil {
+		return inProgress, nil
+	}
+
+	export, err := s.exportRepo.Create(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "[RequestExport] create export failed")
+	}
+	if !s.enqueue(export.ID) {
+		// PENDING 으로 남기면 재개될 때까지 새 요청도 막히므로 실패 처리
+		s.markFailed(export.ID, errors.New("job queue is full"))
+		return nil, errors.Wrapf(ErrDataExportBusy, "[RequestExport] enqueue export %d failed", export.ID)
+	}
+	return export, nil
+}
+
+// GetExport 본인 요청만 조회 가능 (다른 유저 요청이면 NotFound)
+func (s *DataExportService) GetExport(ctx context.Context, userID, exportID int) (*model.DataExport, error) {
+	export, err := s.exportRepo.FindByID(ctx, exportID)
+	if err != nil {
+		return nil, err
+	}
+	if export.UserID != userID {
+		return nil, errors.Wrapf(repository.ErrNotFound, "[GetExport] export %d does not belong to user %d", exportID, userID)
+	}
+	return export, nil
+}
+
+// OpenArchive 본인의 다운로드 가능한 아카이브 (호출자가 Close)
+func (s *DataExportService) OpenArchive(ctx context.Context, userID, exportID int) (*model.DataExport, io.ReadCloser, *storage.ObjectInfo, error) {
+	export, err := s.GetExport(ctx, userID, exportID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !export.IsDownloadable(time.Now()) || export.FileKey == "" {
+		return nil, nil, nil, errors.Wrapf(ErrDataExportUnavailable, "[OpenArchive] export %d status=%s", exportID, export.Status)
+	}
+	body, info, err := s.store.Get(ctx, export.FileKey)
+	if errors.Is(err, storage.ErrObjectNotFound) {
+		return nil, nil, nil, errors.Wrapf(ErrDataExportUnavailable, "[OpenArchive] archive of export %d is missing: %v", exportID, err)
+	} else if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "[OpenArchive] get archive failed")
+	}
+	return export, body, info, nil
+}
+
+// ResumePending 서버 재시작 등으로 중단된 요청(PENDING, 점유 기한이 지난 RUNNING)을 다시 큐에 등록
+// 여러 인스턴스가 같은 요청을 넣어도 generate 의 Claim 으로 한 곳에서만 생성
+func (s *DataExportService) ResumePending(ctx context.Context) error {
+	exports, err := s.exportRepo.ListResumable(ctx, dataExportResumeLimit)
+	if err != nil {
+		return errors.Wrap(err, "[ResumePending] list resumable exports failed")
+	}
+	for _, e := range exports {
+		if !s.enqueue(e.ID) {
+			// 나머지는 다음 정리 주기에 다시 시도
+			log.Ctx(ctx).Warn().Int("export_id", e.ID).Msg("[ResumePending] job queue is full, resume deferred")
+			break
+		}
+	}
+	return nil
+}
+
+// enqueue 대기열이 가득 차면 false (이미 대기 중인 요청이면 다시 넣지 않고 true)
+func (s *DataExportService) enqueue(exportID int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.queued[exportID] {
+		return true
+	}
+
+	name := fmt.Sprintf("data_export:%d", exportID)
+	ok := s.queue.Enqueue(name, func(ctx context.Context) error {
+		s.mu.Lock()
+		delete(s.queued, exportID)
+		s.mu.Unlock()
+		return s.generate(ctx, exportID)
+	})
+	if ok {
+		s.queued[exportID] = true
+	}
+	return ok
+}
+
+// generate ZIP 아카이브 생성 (섹션별 JSON + manifest.json)
+func (s *DataExportService) generate(ctx context.Context, exportID int) error {
+	claimed, err := s.exportRepo.Claim(ctx, exportID, dataExportLease)
+	if err != nil {
+		return errors.Wrap(err, "[generate] claim export failed")
+	}
+	if !claimed {
+		// 다른 인스턴스가 생성 중이거나 이미 끝난 요청
+		log.Ctx(ctx).Info().Int("export_id", exportID).Msg("[generate] export already claimed, skipped")
+		return nil
+	}
+	export, err := s.exportRepo.FindByID(ctx, exportID)
+	if err != nil {
+		return errors.Wrap(err, "[generate] find export failed")
+	}
+
+	fileKey, fileSize, err := s.writeArchive(ctx, export)
+	if err != nil {
+		s.markFailed(exportID, err)
+		return errors.Wrap(err, "[generate] write archive failed")
+	}
+
+	expiresAt := time.Now().Add(s.cfg.DataExportDownloadTTL())
+	if err := s.exportRepo.MarkReady(ctx, exportID, fileKey, fileSize, expiresAt); err != nil {
+		if delErr := s.store.Delete(context.WithoutCancel(ctx), fileKey); delErr != nil {
+			log.Ctx(ctx).Error().Err(delErr).Str("key", fileKey).Msg("[generate] delete orphan archive failed")
+		}
+		return errors.Wrap(err, "[generate] mark ready failed")
+	}
+
+	ready, err := s.exportRepo.FindByID(ctx, exportID)
+	if err != nil {
+		return errors.Wrap(err, "[generate] reload export failed")
+	}
+	NotifyQuietly(ctx, s.notifier, model.NotificationInput{
+		UserID: ready.UserID,
+		Type:   model.NotificationTypeDataExportReady,
+		Payload: map[string]interface{}{
+			"export_id":  ready.ID,
+			"expires_at": ready.ExpiresAt,
+		},
+	})
+	return nil
+}
+
+// writeArchive 메모리에서 ZIP 을 만든 뒤 한 번에 저장 (반쯤 쓰인 아카이브가 다운로드되지 않음)
+// 키에 임의 값을 붙여 같은 요청을 다시 생성해도 이전 객체와 겹치지 않음
+func (s *DataExportService) writeArchive(ctx context.Context, export *model.DataExport) (string, int64, error) {
+	suffix, err := randomHex(8)
+	if err != nil {
+		return "", 0, err
+	}
+	key := fmt.Sprintf("%sexports/%d/export-%d-%s.zip", storage.PrivatePrefix, export.UserID, export.ID, suffix)
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	files := make([]string, 0, len(s.sections))
+	for _, section := range s.sections {
+		data, err := section.Collect(ctx, export.UserID)
+		if err != nil {
+			return "", 0, errors.Wrapf(err, "[writeArchive] collect section failed (file=%s)", section.FileName)
+		}
+		if err := writeJSONEntry(zw, section.FileName, data); err != nil {
+			return "", 0, err
+		}
+		files = append(files, section.FileName)
+	}
+
+	manifest := map[string]interface{}{
+		"export_id":    export.ID,
+		"user_id":      export.UserID,
+		"generated_at": time.Now().UTC(),
+		"files":        files,
+	}
+	if err := writeJSONEntry(zw, "manifest.json", manifest); err != nil {
+		return "", 0, err
+	}
+	if err := zw.Close(); err != nil {
+		return "", 0, errors.Wrap(err, "[writeArchive] close zip writer failed")
+	}
+
+	if err := s.store.Put(ctx, key, buf.Bytes(), "application/zip"); err != nil {
+		return "", 0, errors.Wrapf(err, "[writeArchive] put archive failed (key=%s)", key)
+	}
+	return key, int64(buf.Len()), nil
+}
+
+func writeJSONEntry(zw *zip.Writer, name string, data interface{}) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return errors.Wrapf(err, "[writeJSONEntry] create zip entry failed (name=%s)", name)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(data); err != nil {
+		return errors.Wrapf(err, "[writeJSONEntry] encode failed (name=%s)", name)
+	}
+	return nil
+}
+
+// markFailed 작업 ctx 가 타임아웃으로 끝났을 수 있으므로 별도 ctx 로 상태 기록
+func (s *DataExportService) markFailed(exportID int, cause error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.exportRepo.MarkFailed(ctx, exportID, cause.Error()); err != nil {
+		log.Error().Err(err).Int("export_id", exportID).Msg("[markFailed] mark failed failed")
+	}
+}
+
+// RunCleanupLoop 다운로드 기한이 지난 아카이브 삭제 + 중단된 요청 재개 (ctx 취소 시 종료)
+func (s *DataExportService) RunCleanupLoop(ctx context.Context) {
+	ticker := time.NewTicker(dataExportCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		s.cleanupExpired(ctx)
+		if err := s.ResumePending(ctx); err != nil {
+			log.Error().Err(err).Msg("[RunCleanupLoop] resume pending exports failed")
+		}
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("[RunCleanupLoop] stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *DataExportService) cleanupExpired(ctx context.Context) {
+	exports, err := s.exportRepo.ListExpired(ctx, time.Now(), dataExportCleanupBatch)
+	if err != nil {
+		log.Error().Err(err).Msg("[cleanupExpired] list expired exports failed")
+		return
+	}
+	for _, e := range exports {
+		// 없는 키 삭제는 성공으로 처리하는 저장소만 사용 (다른 오류면 다음 주기에 다시 시도)
+		if e.FileKey != "" {
+			if err := s.store.Delete(ctx, e.FileKey); err != nil {
+				log.Error().Err(err).Int("export_id", e.ID).Msg("[cleanupExpired] delete archive failed")
+				continue
+			}
+		}
+		if err := s.exportRepo.MarkExpired(ctx, e.ID); err != nil {
+			log.Error().Err(err).Int("export_id", e.ID).Msg("[cleanupExpired] mark expired failed")
+		}
+	}
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "[randomHex] read random bytes failed")
+	}
+	return hex.EncodeToString(b), nil
+}
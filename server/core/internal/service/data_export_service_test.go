@@ -0,0 +1,162 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"server/internal/config"
+	"server/internal/model"
+	"server/internal/repository"
+	"server/internal/storage"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// fakeDataExportRepo 요청 1건만 보관 (여러 인스턴스가 같은 DB 를 보는 상황을 흉내)
+type fakeDataExportRepo struct {
+	repository.DataExportRepository
+	mu     sync.Mutex
+	export model.DataExport
+}
+
+func (r *fakeDataExportRepo) FindByID(ctx context.Context, id int) (*model.DataExport, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if id != r.export.ID {
+		return nil, repository.ErrNotFound
+	}
+	e := r.export
+	return &e, nil
+}
+
+func (r *fakeDataExportRepo) Claim(ctx context.Context, id int, lease time.Duration) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.export.Status != model.DataExportStatusPending {
+		return false, nil
+	}
+	r.export.Status = model.DataExportStatusRunning
+	return true, nil
+}
+
+func (r *fakeDataExportRepo) MarkReady(ctx context.Context, id int, fileKey string, fileSize int64, expiresAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.export.Status, r.export.FileKey, r.export.FileSize, r.export.ExpiresAt = model.DataExportStatusReady, fileKey, fileSize, &expiresAt
+	return nil
+}
+
+func (r *fakeDataExportRepo) ListExpired(ctx context.Context, now time.Time, limit int) ([]model.DataExport, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.export.Status == model.DataExportStatusReady && r.export.ExpiresAt.Before(now) {
+		return []model.DataExport{r.export}, nil
+	}
+	return nil, nil
+}
+
+func (r *fakeDataExportRepo) MarkExpired(ctx context.Context, id int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.export.Status, r.export.FileKey = model.DataExportStatusExpired, ""
+	return nil
+}
+
+type nopNotifier struct{}
+
+func (nopNotifier) Notify(ctx context.Context, input model.NotificationInput) error { return nil }
+
+// newTestDataExportService 섹션 1개(profile.json)만 넣은 서비스
+func newTestDataExportService(repo repository.DataExportRepository, store storage.BlobStore) *DataExportService {
+	cfg := &config.AppConfig{}
+	cfg.DataExport.DownloadTTLHours = 1
+	s := NewDataExportService(cfg, repo, nil, nil, nil, nil, nopNotifier{}, store)
+	s.sections = nil
+	s.RegisterSection(ExportSection{
+		FileName: "profile.json",
+		Collect: func(ctx context.Context, userID int) (interface{}, error) {
+			return map[string]int{"id": userID}, nil
+		},
+	})
+	return s
+}
+
+// TestDataExportArchiveSharedAcrossInstances 한 인스턴스가 만든 아카이브를 다른 인스턴스가 내려주고 정리
+func TestDataExportArchiveSharedAcrossInstances(t *testing.T) {
+	const userID, exportID = 7, 42
+	store, err := storage.NewLocalBlobStore(t.TempDir(), "http://localhost/media")
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := &fakeDataExportRepo{export: model.DataExport{ID: exportID, UserID: userID, Status: model.DataExportStatusPending}}
+	writer := newTestDataExportService(repo, store)
+	reader := newTestDataExportService(repo, store)
+	ctx := context.Background()
+
+	if err := writer.generate(ctx, exportID); err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	key := repo.export.FileKey
+	if !storage.IsPrivate(key) || !strings.HasPrefix(key, "private/exports/7/export-42-") {
+		t.Fatalf("file key = %q, want private/exports/7/export-42-*", key)
+	}
+
+	if _, _, _, err := reader.OpenArchive(ctx, userID+1, exportID); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("other user's OpenArchive err = %v, want ErrNotFound", err)
+	}
+	_, body, info, err := reader.OpenArchive(ctx, userID, exportID)
+	if err != nil {
+		t.Fatalf("OpenArchive: %v", err)
+	}
+	data, _ := io.ReadAll(body)
+	body.Close()
+	if info.Size != repo.export.FileSize || int64(len(data)) != info.Size {
+		t.Errorf("size = %d (info %d), want %d", len(data), info.Size, repo.export.FileSize)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("archive is not a zip: %v", err)
+	}
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	if strings.Join(names, ",") != "profile.json,manifest.json" {
+		t.Errorf("archive files = %v", names)
+	}
+
+	// 다른 인스턴스의 정리 작업이 저장소의 객체까지 삭제
+	past := time.Now().Add(-time.Minute)
+	repo.export.ExpiresAt = &past
+	reader.cleanupExpired(ctx)
+	if repo.export.Status != model.DataExportStatusExpired {
+		t.Errorf("status = %s, want EXPIRED", repo.export.Status)
+	}
+	if _, _, err := store.Get(ctx, key); !errors.Is(err, storage.ErrObjectNotFound) {
+		t.Errorf("archive still in store after cleanup (err=%v)", err)
+	}
+	if _, _, _, err := reader.OpenArchive(ctx, userID, exportID); !errors.Is(err, ErrDataExportUnavailable) {
+		t.Errorf("expired OpenArchive err = %v, want ErrDataExportUnavailable", err)
+	}
+}
+
+func TestDataExportOpenArchiveMissingObject(t *testing.T) {
+	store, err := storage.NewLocalBlobStore(t.TempDir(), "http://localhost/media")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expiresAt := time.Now().Add(time.Hour)
+	repo := &fakeDataExportRepo{export: model.DataExport{
+		ID: 1, UserID: 1, Status: model.DataExportStatusReady,
+		FileKey: "private/exports/1/export-1-gone.zip", ExpiresAt: &expiresAt,
+	}}
+	s := newTestDataExportService(repo, store)
+	if _, _, _, err := s.OpenArchive(context.Background(), 1, 1); !errors.Is(err, ErrDataExportUnavailable) {
+		t.Errorf("err = %v, want ErrDataExportUnavailable", err)
+	}
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"server/internal/apperror"
+	"server/internal/model"
+	"server/internal/pagination"
+	"server/internal/preference"
+	"server/internal/repository"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// ErrSelfFollow 자기 자신을 팔로우하려는 경우
+var ErrSelfFollow = apperror.BadRequest("SELF_FOLLOW", "자기 자신을 팔로우할 수 없습니다.")
+
+// FollowService 팔로우/언팔로우, 팔로우 승인제(privacy.follow_approval) 요청 처리, 팔로워/팔로잉 목록
+type FollowService struct {
+	userRepo   repository.UserRepository
+	followRepo repository.FollowRepository
+	prefSvc    *PreferenceService
+	blockSvc   *BlockService
+	notifier   Notifier
+}
+
+func NewFollowService(
+	userRepo repository.UserRepository,
+	followRepo repository.FollowRepository,
+	prefSvc *PreferenceService,
+	blockSvc *BlockService,
+	notifier Notifier,
+) *FollowService {
+	return &FollowService{
+		userRepo:   userRepo,
+		followRepo: followRepo,
+		prefSvc:    prefSvc,
+		blockSvc:   blockSvc,
+		notifier:   notifier,
+	}
+}
+
+// Follow 대상이 팔로우 승인제를 켰으면 PENDING 요청, 아니면 바로 ACCEPTED (대상에게 알림)
+// 이미 팔로우 중이거나 요청한 상태면 기존 관계를 그대로 반환, 차단 관계면 ErrBlocked
+func (s *FollowService) Follow(ctx context.Context, followerID, followeeID int) (*model.Follow, error) {
+	if followerID == followeeID {
+		return nil, ErrSelfFollow
+	}
+	if err := s.ensureActiveUser(ctx, followeeID); err != nil {
+		return nil, errors.Wrap(err, "[Follow] find followee failed")
+	}
+	if err := s.blockSvc.CheckInteraction(ctx, followerID, followeeID); err != nil {
+		return nil, err
+	}
+	if existing, err := s.followRepo.Find(ctx, followerID, followeeID); err == nil {
+		return existing, nil
+	} else if !errors.Is(err, repository.ErrNotFound) {
+		return nil, errors.Wrap(err, "[Follow] find existing follow failed")
+	}
+
+	requireApproval, err := s.prefSvc.Bool(ctx, followeeID, preference.KeyPrivacyFollowApproval)
+	if err != nil {
+		return nil, errors.Wrap(err, "[Follow] read follow approval preference failed")
+	}
+	status := model.FollowStatusAccepted
+	if requireApproval {
+		status = model.FollowStatusPending
+	}
+
+	follow, err := s.followRepo.Create(ctx, followerID, followeeID, status)
+	if err != nil {
+		return nil, errors.Wrap(err, "[Follow] create follow failed")
+	}
+
+	notificationType := model.NotificationTypeNewFollower
+	if follow.Status == model.FollowStatusPending {
+		notificationType = model.NotificationTypeFollowRequest
+	}
+	s.notifyFollowEvent(ctx, notificationType, followeeID, followerID)
+	return follow, nil
+}
+
+// Unfollow 팔로우 취소 (승인 대기 중인 요청 취소 포함)
+func (s *FollowService) Unfollow(ctx context.Context, followerID, followeeID int) error {
+	return s.followRepo.Delete(ctx, followerID, followeeID)
+}
+
+// AcceptRequest followeeID 가 받은 requesterID 의 팔로우 요청 승인
+func (s *FollowService) AcceptRequest(ctx context.Context, followeeID, requesterID int) (*model.Follow, error) {
+	if err := s.blockSvc.CheckInteraction(ctx, followeeID, requesterID); err != nil {
+		return nil, err
+	}
+	follow, err := s.followRepo.Accept(ctx, requesterID, followeeID)
+	if err != nil {
+		return nil, err
+	}
+	s.notifyFollowEvent(ctx, model.NotificationTypeFollowAccepted, requesterID, followeeID)
+	return follow, nil
+}
+
+// notifyFollowEvent 같은 종류의 읽지 않은 팔로우 알림은 하나로 묶음 ("OOO님 외 N명이 팔로우합니다")
+func (s *FollowService) notifyFollowEvent(ctx context.Context, notificationType string, recipientID, actorID int) {
+	actor, err := s.userRepo.FindByID(ctx, actorID)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Int("actor_id", actorID).Msg("[notifyFollowEvent] find actor failed")
+		return
+	}
+	NotifyQuietly(ctx, s.notifier, model.NotificationInput{
+		UserID:  recipientID,
+		Type:    notificationType,
+		ActorID: actorID,
+		Payload: map[string]interface{}{
+			"user_id":       actor.ID,
+			"nickname":      actor.Nickname,
+			"profile_image": actor.ProfileImage,
+		},
+		GroupKey: notificationType,
+	})
+}
+
+// RejectRequest 받은 팔로우 요청 거절 (요청 행 삭제 → 상대는 다시 요청 가능)
+func (s *FollowService) RejectRequest(ctx context.Context, followeeID, requesterID int) error {
+	follow, err := s.followRepo.Find(ctx, requesterID, followeeID)
+	if err != nil {
+		return err
+	}
+	if follow.Status != model.FollowStatusPending {
+		return errors.Wrapf(repository.ErrNotFound, "[RejectRequest] no pending request from user %d", requesterID)
+	}
+	return s.followRepo.Delete(ctx, requesterID, followeeID)
+}
+
+// ListFollowers viewerID(조회하는 유저)와 차단 관계인 유저의 목록은 없는 유저처럼 NotFound
+func (s *FollowService) ListFollowers(ctx context.Context, userID, viewerID int, page pagination.Request) (pagination.Page[model.FollowUser], error) {
+	if err := s.ensureVisibleUser(ctx, userID, viewerID); err != nil {
+		return pagination.Page[model.FollowUser]{}, errors.Wrap(err, "[ListFollowers] find user failed")
+	}
+	users, err := s.followRepo.ListFollowers(ctx, userID, viewerID, model.FollowStatusAccepted, page)
+	if err != nil {
+		return pagination.Page[model.FollowUser]{}, errors.Wrap(err, "[ListFollowers] list followers failed")
+	}
+	return pagination.NewPage(users, page, repository.FollowSortKey), nil
+}
+
+func (s *FollowService) ListFollowing(ctx context.Context, userID, viewerID int, page pagination.Request) (pagination.Page[model.FollowUser], error) {
+	if err := s.ensureVisibleUser(ctx, userID, viewerID); err != nil {
+		return pagination.Page[model.FollowUser]{}, errors.Wrap(err, "[ListFollowing] find user failed")
+	}
+	users, err := s.followRepo.ListFollowing(ctx, userID, viewerID, page)
+	if err != nil {
+		return pagination.Page[model.FollowUser]{}, errors.Wrap(err, "[ListFollowing] list following failed")
+	}
+	return pagination.NewPage(users, page, repository.FollowSortKey), nil
+}
+
+// ListRequests 본인이 받은 승인 대기 중인 팔로우 요청
+func (s *FollowService) ListRequests(ctx context.Context, userID int, page pagination.Request) (pagination.Page[model.FollowUser], error) {
+	users, err := s.followRepo.ListFollowers(ctx, userID, userID, model.FollowStatusPending, page)
+	if err != nil {
+		return pagination.Page[model.FollowUser]{}, errors.Wrap(err, "[ListRequests] list follow requests failed")
+	}
+	return pagination.NewPage(users, page, repository.FollowSortKey), nil
+}
+
+// IsFollowing followerID 가 followeeID 를 팔로우 중인지 (승인 대기 요청은 제외)
+func (s *FollowService) IsFollowing(ctx context.Context, followerID, followeeID int) (bool, error) {
+	follow, err := s.followRepo.Find(ctx, followerID, followeeID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return follow.Status == model.FollowStatusAccepted, nil
+}
+
+func (s *FollowService) Counts(ctx context.Context, userID int) (*model.FollowCounts, error) {
+	return s.followRepo.Counts(ctx, userID)
+}
+
+// followExportSort 내보내기는 오래된 순으로 전체 수집
+var followExportSort = pagination.Sort{Name: "created_at", Field: repository.FollowSorts["created_at"]}
+
+// ExportSection 개인정보 내보내기 아카이브에 팔로우 관계 포함
+func (s *FollowService) ExportSection() ExportSection {
+	return ExportSection{
+		FileName: "follows.json",
+		Collect: func(ctx context.Context, userID int) (interface{}, error) {
+			followers, err := pagination.CollectAll(followExportSort, repository.FollowSortKey, func(page pagination.Request) ([]model.FollowUser, error) {
+				return s.followRepo.ListFollowers(ctx, userID, userID, model.FollowStatusAccepted, page)
+			})
+			if err != nil {
+				return nil, err
+			}
+			following, err := pagination.CollectAll(followExportSort, repository.FollowSortKey, func(page pagination.Request) ([]model.FollowUser, error) {
+				return s.followRepo.ListFollowing(ctx, userID, userID, page)
+			})
+			if err != nil {
+				return nil, err
+			}
+			pending, err := pagination.CollectAll(followExportSort, repository.FollowSortKey, func(page pagination.Request) ([]model.FollowUser, error) {
+				return s.followRepo.ListFollowers(ctx, userID, userID, model.FollowStatusPending, page)
+			})
+			if err != nil {
+				return nil, err
+			}
+			return map[string]interface{}{
+				"followers":        followers,
+				"following":        following,
+				"pending_requests": pending,
+			}, nil
+		},
+	}
+}
+
+// ensureVisibleUser 탈퇴했거나 viewerID 와 차단 관계인 유저는 없는 유저로 취급
+func (s *FollowService) ensureVisibleUser(ctx context.Context, userID, viewerID int) error {
+	if err := s.ensureActiveUser(ctx, userID); err != nil {
+		return err
+	}
+	if err := s.blockSvc.CheckInteraction(ctx, viewerID, userID); errors.Is(err, ErrBlocked) {
+		return errors.Wrapf(repository.ErrNotFound, "[ensureVisibleUser] user %d is blocked with viewer %d", userID, viewerID)
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// ensureActiveUser 탈퇴(soft delete)한 유저는 없는 유저로 취급
+func (s *FollowService) ensureActiveUser(ctx context.Context, userID int) error {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.DeletedAt != nil {
+		return errors.Wrapf(repository.ErrNotFound, "[ensureActiveUser] user %d is deleted", userID)
+	}
+	return nil
+}
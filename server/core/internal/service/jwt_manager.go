@@ -13,10 +13,18 @@ import (
 	"github.com/pkg/errors"
 )
 
+const (
+	// AccessTokenScope access token 에 기본으로 부여하는 scope (공백 구분)
+	AccessTokenScope = "api"
+	// RestoreTokenScope 탈퇴 유예 기간 중 재로그인한 유저의 계정 복구 전용 scope
+	RestoreTokenScope = "restore"
+)
+
 type JWTManager struct {
 	SecretKey       string
 	AccessTokenTTL  time.Duration
 	RefreshTokenTTL time.Duration
+	RestoreTokenTTL time.Duration
 }
 
 func NewJWTManager() *JWTManager {
@@ -31,16 +39,19 @@ func NewJWTManager() *JWTManager {
 		SecretKey:       secret,
 		AccessTokenTTL:  accessTTL,
 		RefreshTokenTTL: refreshTTL,
+		RestoreTokenTTL: 10 * time.Minute,
 	}
 }
 
-// GenerateAccessToken user 정보 기반으로 Access JWT 발급
-func (j *JWTManager) GenerateAccessToken(user *model.User) (string, error) {
+// GenerateAccessToken user 정보 기반으로 Access JWT 발급 (sessionID: 발급 근거가 된 refresh_tokens.id)
+func (j *JWTManager) GenerateAccessToken(user *model.User, sessionID int) (string, error) {
 	now := time.Now()
 	claims := jwt.MapClaims{
 		"sub":   fmt.Sprintf("user:%d", user.ID), // ex) "user:123"
 		"email": user.Email,
 		"role":  user.Role,
+		"sid":   sessionID,
+		"scope": AccessTokenScope,
 		"exp":   now.Add(j.AccessTokenTTL).Unix(),
 		"iat":   now.Unix(),
 		"iss":   "step-journey", // issuer
@@ -63,6 +74,20 @@ func (j *JWTManager) GenerateRefreshToken(user *model.User) (string, error) {
 	return token.SignedString([]byte(j.SecretKey))
 }
 
+// GenerateRestoreToken 탈퇴 유예 기간 중인 유저가 계정 복구를 요청할 때 사용할 단기 토큰 발급
+func (j *JWTManager) GenerateRestoreToken(user *model.User) (string, error) {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"sub":   fmt.Sprintf("user:%d", user.ID),
+		"exp":   now.Add(j.RestoreTokenTTL).Unix(),
+		"iat":   now.Unix(),
+		"iss":   "step-journey",
+		"scope": RestoreTokenScope,
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(j.SecretKey))
+}
+
 // VerifyToken 토큰 파싱 & 검증 - jwt.ParseWithClaims 를 사용하여 커스텀 클레임(mapClaimsWrapper)으로 파싱
 func (j *JWTManager) VerifyToken(tokenStr string) (*jwt.Token, error) {
 	claims := &mapClaimsWrapper{MapClaims: jwt.MapClaims{}}
@@ -82,10 +107,14 @@ func (j *JWTManager) VerifyToken(tokenStr string) (*jwt.Token, error) {
 	return token, nil
 }
 
-// MapClaimsWithSubID 인터페이스: 토큰 Claims 에서 userID를 추출하기 위한 메서드 GetUserID()를 정의
+// MapClaimsWithSubID 인터페이스: 토큰 Claims 에서 userID 및 Principal 구성 정보를 추출하기 위한 메서드 정의
 type MapClaimsWithSubID interface {
 	jwt.Claims
 	GetUserID() int
+	GetEmail() string
+	GetRole() string
+	GetSessionID() int
+	GetScopes() []string
 }
 
 // mapClaimsWrapper jwt.MapClaims 를 embedding 하여 MapClaimsWithSubID 인터페이스를 구현
@@ -130,3 +159,30 @@ func (w *mapClaimsWrapper) GetUserID() int {
 	}
 	return id
 }
+
+// GetEmail "email" 클레임 (없으면 빈 문자열)
+func (w *mapClaimsWrapper) GetEmail() string {
+	email, _ := w.MapClaims["email"].(string)
+	return email
+}
+
+// GetRole "role" 클레임 (없으면 빈 문자열)
+func (w *mapClaimsWrapper) GetRole() string {
+	role, _ := w.MapClaims["role"].(string)
+	return role
+}
+
+// GetSessionID "sid" 클레임 (JSON 숫자는 float64 로 디코딩됨, 없으면 0)
+func (w *mapClaimsWrapper) GetSessionID() int {
+	sid, ok := w.MapClaims["sid"].(float64)
+	if !ok {
+		return 0
+	}
+	return int(sid)
+}
+
+// GetScopes "scope" 클레임을 공백 기준으로 분리
+func (w *mapClaimsWrapper) GetScopes() []string {
+	scope, _ := w.MapClaims["scope"].(string)
+	return strings.Fields(scope)
+}
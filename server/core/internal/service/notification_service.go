@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"server/internal/model"
+	"server/internal/pagination"
+	"server/internal/preference"
+	"server/internal/repository"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// Notifier 다른 기능(팔로우, 댓글, 여정 마일스톤, 보안 이벤트 등)이 알림을 보낼 때 사용
+// 알림 실패가 원래 동작을 막지 않도록 호출 측에서는 에러를 로깅만 하는 것을 권장 (NotifyQuietly)
+type Notifier interface {
+	Notify(ctx context.Context, input model.NotificationInput) error
+}
+
+// notificationPreferenceKeys 알림 종류별로 수신 여부를 정하는 설정 키
+// 등록되지 않은 종류(보안 이벤트, 내보내기 완료 등)는 끌 수 없음
+var notificationPreferenceKeys = map[string]string{
+	model.NotificationTypeNewFollower:      preference.KeyNotificationsNewFollower,
+	model.NotificationTypeFollowRequest:    preference.KeyNotificationsFollowReq,
+	model.NotificationTypeFollowAccepted:   preference.KeyNotificationsFollowReq,
+	model.NotificationTypeComment:          preference.KeyNotificationsComment,
+	model.NotificationTypeJourneyMilestone: preference.KeyNotificationsMilestone,
+}
+
+// NotificationService 인앱 알림함 (Notifier 구현 + 조회/읽음 처리)
+type NotificationService struct {
+	notificationRepo repository.NotificationRepository
+	prefSvc          *PreferenceService
+	blockSvc         *BlockService
+}
+
+func NewNotificationService(
+	notificationRepo repository.NotificationRepository,
+	prefSvc *PreferenceService,
+	blockSvc *BlockService,
+) *NotificationService {
+	return &NotificationService{
+		notificationRepo: notificationRepo,
+		prefSvc:          prefSvc,
+		blockSvc:         blockSvc,
+	}
+}
+
+// Notify 받는 유저가 해당 종류 알림을 껐거나 발생시킨 유저와 차단 관계면 조용히 건너뜀
+func (s *NotificationService) Notify(ctx context.Context, input model.NotificationInput) error {
+	if input.ActorID != 0 && input.ActorID == input.UserID {
+		return nil
+	}
+	if key, ok := notificationPreferenceKeys[input.Type]; ok {
+		enabled, err := s.prefSvc.Bool(ctx, input.UserID, key)
+		if err != nil {
+			return errors.Wrapf(err, "[Notify] read preference failed (type=%s)", input.Type)
+		}
+		if !enabled {
+			return nil
+		}
+	}
+	if err := s.blockSvc.CheckInteraction(ctx, input.ActorID, input.UserID); errors.Is(err, ErrBlocked) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "[Notify] check block failed")
+	}
+
+	n := &model.Notification{
+		UserID:   input.UserID,
+		Type:     input.Type,
+		Payload:  input.Payload,
+		GroupKey: input.GroupKey,
+	}
+	if input.ActorID != 0 {
+		actorID := input.ActorID
+		n.ActorID = &actorID
+	}
+	if _, err := s.notificationRepo.Create(ctx, n); err != nil {
+		return errors.Wrapf(err, "[Notify] create notification failed (type=%s)", input.Type)
+	}
+	return nil
+}
+
+// NotifyQuietly 알림 실패를 로깅만 하고 무시 (알림 때문에 팔로우 등 원래 요청이 실패하지 않도록)
+func NotifyQuietly(ctx context.Context, notifier Notifier, input model.NotificationInput) {
+	if err := notifier.Notify(ctx, input); err != nil {
+		log.Ctx(ctx).Warn().Err(err).
+			Int("user_id", input.UserID).
+			Str("type", input.Type).
+			Msg("[NotifyQuietly] notify failed")
+	}
+}
+
+func (s *NotificationService) List(ctx context.Context, userID int, unreadOnly bool, page pagination.Request) (pagination.Page[model.Notification], error) {
+	notifications, err := s.notificationRepo.List(ctx, userID, unreadOnly, page)
+	if err != nil {
+		return pagination.Page[model.Notification]{}, errors.Wrap(err, "[NotificationService.List] list failed")
+	}
+	return pagination.NewPage(notifications, page, repository.NotificationSortKey), nil
+}
+
+func (s *NotificationService) CountUnread(ctx context.Context, userID int) (int, error) {
+	return s.notificationRepo.CountUnread(ctx, userID)
+}
+
+func (s *NotificationService) MarkRead(ctx context.Context, userID, notificationID int) error {
+	return s.notificationRepo.MarkRead(ctx, userID, notificationID)
+}
+
+func (s *NotificationService) MarkAllRead(ctx context.Context, userID int) (int64, error) {
+	return s.notificationRepo.MarkAllRead(ctx, userID)
+}
+
+// notificationExportSort 내보내기는 오래된 순으로 전체 수집
+var notificationExportSort = pagination.Sort{Name: "updated_at", Field: repository.NotificationSorts["updated_at"]}
+
+// ExportSection 개인정보 내보내기 아카이브에 알림함 포함
+func (s *NotificationService) ExportSection() ExportSection {
+	return ExportSection{
+		FileName: "notifications.json",
+		Collect: func(ctx context.Context, userID int) (interface{}, error) {
+			return pagination.CollectAll(notificationExportSort, repository.NotificationSortKey, func(page pagination.Request) ([]model.Notification, error) {
+				return s.notificationRepo.List(ctx, userID, false, page)
+			})
+		},
+	}
+}
+
+// SecurityExportSection 개인정보 내보내기 아카이브에 보안 이벤트(계정 복구 등 security.event 알림) 포함
+func (s *NotificationService) SecurityExportSection() ExportSection {
+	return ExportSection{
+		FileName: "security_events.json",
+		Collect: func(ctx context.Context, userID int) (interface{}, error) {
+			all, err := pagination.CollectAll(notificationExportSort, repository.NotificationSortKey, func(page pagination.Request) ([]model.Notification, error) {
+				return s.notificationRepo.List(ctx, userID, false, page)
+			})
+			if err != nil {
+				return nil, err
+			}
+			events := make([]map[string]interface{}, 0)
+			for _, n := range all {
+				if n.Type != model.NotificationTypeSecurity {
+					continue
+				}
+				events = append(events, map[string]interface{}{
+					"event":      n.Payload["event"],
+					"payload":    n.Payload,
+					"created_at": n.CreatedAt,
+				})
+			}
+			return events, nil
+		},
+	}
+}
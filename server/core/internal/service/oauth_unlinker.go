@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"server/internal/config"
+	"server/internal/model"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// OAuthUnlinker 회원 탈퇴 시 OAuth 제공자(Kakao/Naver)에 앱 연결 끊기를 요청
+type OAuthUnlinker struct {
+	httpClient   *http.Client
+	oAuthSecrets *config.OAuthSecrets
+}
+
+func NewOAuthUnlinker(oAuthSecrets *config.OAuthSecrets) *OAuthUnlinker {
+	return &OAuthUnlinker{
+		httpClient:   &http.Client{Timeout: 10 * time.Second},
+		oAuthSecrets: oAuthSecrets,
+	}
+}
+
+// Unlink 제공자별 연결 끊기 API 호출 (unlink API 가 없는 제공자는 무시)
+func (u *OAuthUnlinker) Unlink(ctx context.Context, identity model.UserIdentity) error {
+	switch identity.Provider {
+	case "kakao":
+		return u.unlinkKakao(ctx, identity)
+	case "naver":
+		return u.unlinkNaver(ctx, identity)
+	default:
+		return nil
+	}
+}
+
+// unlinkKakao Admin Key 가 있으면 서비스 앱 어드민 키로, 없으면 유저 access token 으로 연결 끊기
+// https://developers.kakao.com/docs/latest/ko/kakaologin/rest-api#unlink
+func (u *OAuthUnlinker) unlinkKakao(ctx context.Context, identity model.UserIdentity) error {
+	unlinkURL := "https://kapi.kakao.com/v1/user/unlink"
+
+	var body io.Reader
+	authHeader := ""
+	if u.oAuthSecrets.KakaoAdminKey != "" {
+		form := url.Values{}
+		form.Set("target_id_type", "user_id")
+		form.Set("target_id", identity.ProviderUserID)
+		body = strings.NewReader(form.Encode())
+		authHeader = "KakaoAK " + u.oAuthSecrets.KakaoAdminKey
+	} else {
+		if identity.AccessToken == "" {
+			return errors.New("[unlinkKakao] neither admin key nor user access token is available")
+		}
+		authHeader = "Bearer " + identity.AccessToken
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", unlinkURL, body)
+	if err != nil {
+		return errors.Wrap(err, "[unlinkKakao] new request failed")
+	}
+	req.Header.Set("Authorization", authHeader)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := u.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "[unlinkKakao] unlink request failed")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(res.Body)
+		return errors.Errorf("[unlinkKakao] unlink failed, status=%d, body=%s", res.StatusCode, string(bodyBytes))
+	}
+	_, _ = io.Copy(io.Discard, res.Body)
+	return nil
+}
+
+// unlinkNaver 저장된 refresh token 으로 access token 을 갱신한 뒤 토큰 삭제(연동 해제) 요청
+// https://developers.naver.com/docs/login/devguide/devguide.md#5-3-1-네이버-로그인-연동-해제
+func (u *OAuthUnlinker) unlinkNaver(ctx context.Context, identity model.UserIdentity) error {
+	accessToken := identity.AccessToken
+	if identity.RefreshToken != "" {
+		refreshed, err := u.refreshNaverAccessToken(ctx, identity.RefreshToken)
+		if err != nil {
+			return errors.Wrap(err, "[unlinkNaver] refresh access token failed")
+		}
+		accessToken = refreshed
+	}
+	if accessToken == "" {
+		return errors.New("[unlinkNaver] no access token available")
+	}
+
+	params := url.Values{}
+	params.Set("grant_type", "delete")
+	params.Set("client_id", u.oAuthSecrets.NaverClientID)
+	params.Set("client_secret", u.oAuthSecrets.NaverClientSecret)
+	params.Set("access_token", accessToken)
+	params.Set("service_provider", "NAVER")
+
+	var resp struct {
+		Result string `json:"result"`
+		Error  string `json:"error"`
+	}
+	if err := u.postNaverToken(ctx, params, &resp); err != nil {
+		return errors.Wrap(err, "[unlinkNaver] delete token request failed")
+	}
+	if resp.Result != "success" {
+		return errors.Errorf("[unlinkNaver] delete token failed, result=%s, error=%s", resp.Result, resp.Error)
+	}
+	return nil
+}
+
+func (u *OAuthUnlinker) refreshNaverAccessToken(ctx context.Context, refreshToken string) (string, error) {
+	params := url.Values{}
+	params.Set("grant_type", "refresh_token")
+	params.Set("client_id", u.oAuthSecrets.NaverClientID)
+	params.Set("client_secret", u.oAuthSecrets.NaverClientSecret)
+	params.Set("refresh_token", refreshToken)
+
+	var resp struct {
+		AccessToken string `json:"access_token"`
+		Error       string `json:"error"`
+	}
+	if err := u.postNaverToken(ctx, params, &resp); err != nil {
+		return "", err
+	}
+	if resp.AccessToken == "" {
+		return "", errors.Errorf("[refreshNaverAccessToken] empty access token, error=%s", resp.Error)
+	}
+	return resp.AccessToken, nil
+}
+
+func (u *OAuthUnlinker) postNaverToken(ctx context.Context, params url.Values, out interface{}) error {
+	tokenURL := "https://nid.naver.com/oauth2.0/token"
+	req, err := http.NewRequestWithContext(ctx, "POST", tokenURL, strings.NewReader(params.Encode()))
+	if err != nil {
+		return errors.Wrap(err, "[postNaverToken] new request failed")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := u.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "[postNaverToken] request failed")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf("[postNaverToken] unexpected status=%d", res.StatusCode)
+	}
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return errors.Wrap(err, "[postNaverToken] decode response failed")
+	}
+	return nil
+}
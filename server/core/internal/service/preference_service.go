@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"server/internal/preference"
+	"server/internal/repository"
+	"server/pkg/validator"
+
+	"github.com/pkg/errors"
+)
+
+// PreferenceService 유저 설정 조회/수정
+// 다른 서비스는 users 에 컬럼을 추가하는 대신 preference 레지스트리에 키를 등록하고 Bool/String 으로 읽음
+type PreferenceService struct {
+	registry *preference.Registry
+	prefRepo repository.PreferenceRepository
+}
+
+func NewPreferenceService(registry *preference.Registry, prefRepo repository.PreferenceRepository) *PreferenceService {
+	return &PreferenceService{
+		registry: registry,
+		prefRepo: prefRepo,
+	}
+}
+
+// Registry 기능별 설정 키 등록용
+func (s *PreferenceService) Registry() *preference.Registry {
+	return s.registry
+}
+
+// Get 기본값을 채운 전체 설정
+func (s *PreferenceService) Get(ctx context.Context, userID int) (map[string]interface{}, error) {
+	stored, err := s.prefRepo.Get(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "[PreferenceService.Get] get stored preferences failed")
+	}
+	return s.registry.Resolve(stored), nil
+}
+
+// Update JSON Merge Patch (RFC 7396) 방식: 보낸 키만 변경, null 은 기본값으로 되돌림
+// 알 수 없는 키/잘못된 값은 validator.Errors 로 모아서 반환 (하나라도 있으면 아무것도 저장하지 않음)
+func (s *PreferenceService) Update(ctx context.Context, userID int, patch map[string]json.RawMessage) (map[string]interface{}, error) {
+	set := make(map[string]interface{})
+	var unset []string
+	var verrs validator.Errors
+
+	for key, raw := range patch {
+		def, ok := s.registry.Lookup(key)
+		if !ok {
+			verrs.Add(key, "알 수 없는 설정입니다")
+			continue
+		}
+		if string(raw) == "null" {
+			unset = append(unset, key)
+			continue
+		}
+		v, reason := def.Parse(raw)
+		if reason != "" {
+			verrs.Add(key, reason)
+			continue
+		}
+		set[key] = v
+	}
+	if err := verrs.Err(); err != nil {
+		return nil, err
+	}
+
+	stored, err := s.prefRepo.Merge(ctx, userID, set, unset)
+	if err != nil {
+		return nil, errors.Wrap(err, "[PreferenceService.Update] merge failed")
+	}
+	return s.registry.Resolve(stored), nil
+}
+
+// Bool bool 설정 값 (등록되지 않았거나 bool 이 아닌 키면 에러)
+func (s *PreferenceService) Bool(ctx context.Context, userID int, key string) (bool, error) {
+	v, err := s.value(ctx, userID, key)
+	if err != nil {
+		return false, err
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, errors.Errorf("[PreferenceService.Bool] preference %q is not a bool", key)
+	}
+	return b, nil
+}
+
+// String 문자열(enum) 설정 값
+func (s *PreferenceService) String(ctx context.Context, userID int, key string) (string, error) {
+	v, err := s.value(ctx, userID, key)
+	if err != nil {
+		return "", err
+	}
+	str, ok := v.(string)
+	if !ok {
+		return "", errors.Errorf("[PreferenceService.String] preference %q is not a string", key)
+	}
+	return str, nil
+}
+
+func (s *PreferenceService) value(ctx context.Context, userID int, key string) (interface{}, error) {
+	if _, ok := s.registry.Lookup(key); !ok {
+		return nil, errors.Errorf("[PreferenceService] unknown preference key %q", key)
+	}
+	prefs, err := s.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return prefs[key], nil
+}
+
+// ExportSection 개인정보 내보내기 아카이브에 설정 포함
+func (s *PreferenceService) ExportSection() ExportSection {
+	return ExportSection{
+		FileName: "preferences.json",
+		Collect: func(ctx context.Context, userID int) (interface{}, error) {
+			return s.Get(ctx, userID)
+		},
+	}
+}
@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"server/internal/apperror"
+	"server/internal/model"
+	"server/internal/preference"
+	"server/internal/repository"
+	"server/pkg/validator"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrHandleTaken 다른 유저가 쓰고 있거나 예약 기간 안의 예전 handle 로 변경하려는 경우
+	ErrHandleTaken = apperror.Conflict("HANDLE_TAKEN", "이미 사용 중인 handle 입니다.")
+	// ErrHandleChangeTooSoon 마지막 변경 후 handlePolicy.Cooldown 이 지나지 않음 (details.retry_at 이후 가능)
+	ErrHandleChangeTooSoon = apperror.New(apperror.TypeTooManyRequests, "HANDLE_CHANGE_TOO_SOON", "handle 은 30일에 한 번만 변경할 수 있습니다.")
+)
+
+// handlePolicy handle 변경은 30일에 한 번, 이전 handle 은 180일 동안 예약 (이후 리다이렉트 종료, 다른 유저가 사용 가능)
+var handlePolicy = model.HandlePolicy{
+	Cooldown:    30 * 24 * time.Hour,
+	Reservation: 180 * 24 * time.Hour,
+}
+
+// ProfileSection 공개 프로필 응답에 키 1개로 들어갈 데이터
+// 새 기능이 공개할 데이터를 가지면 RegisterSection 으로 추가 (예: 여정 기능의 "journeys")
+// Collect 는 공개 범위 확인을 통과한 뒤에만 호출되며, 자기 데이터의 공개 여부(비공개 여정 등)는 각 기능이 viewerID 로 판단
+type ProfileSection struct {
+	Key     string
+	Collect func(ctx context.Context, owner *model.User, viewerID int) (interface{}, error)
+}
+
+// PublicProfile GetPublicProfile 결과 (RedirectHandle 이 있으면 예전 handle 로 조회한 것)
+type PublicProfile struct {
+	Fields         map[string]interface{}
+	RedirectHandle string
+}
+
+// ProfileService @handle 관리와 공개 프로필 조회 (profile.* 설정으로 공개 항목 결정)
+type ProfileService struct {
+	handleRepo repository.HandleRepository
+	prefSvc    *PreferenceService
+	blockSvc   *BlockService
+	followSvc  *FollowService
+	validator  *validator.Validator
+	sections   []ProfileSection
+}
+
+func NewProfileService(
+	handleRepo repository.HandleRepository,
+	prefSvc *PreferenceService,
+	blockSvc *BlockService,
+	followSvc *FollowService,
+) *ProfileService {
+	s := &ProfileService{
+		handleRepo: handleRepo,
+		prefSvc:    prefSvc,
+		blockSvc:   blockSvc,
+		followSvc:  followSvc,
+		validator:  validator.NewValidator(),
+	}
+
+	s.RegisterSection(ProfileSection{
+		Key: "stats",
+		Collect: func(ctx context.Context, owner *model.User, viewerID int) (interface{}, error) {
+			if owner.ID != viewerID {
+				show, err := s.prefSvc.Bool(ctx, owner.ID, preference.KeyProfileShowStats)
+				if err != nil || !show {
+					return nil, err
+				}
+			}
+			counts, err := s.followSvc.Counts(ctx, owner.ID)
+			if err != nil {
+				return nil, err
+			}
+			return map[string]interface{}{
+				"followers_count": counts.Followers,
+				"following_count": counts.Following,
+				"joined_at":       owner.CreatedAt,
+			}, nil
+		},
+	})
+	return s
+}
+
+// RegisterSection 공개 프로필에 포함할 섹션 추가
+func (s *ProfileService) RegisterSection(section ProfileSection) {
+	s.sections = append(s.sections, section)
+}
+
+// NormalizeHandle 경로/입력값의 @ 접두사 제거 후 소문자로 통일
+func NormalizeHandle(handle string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(handle), "@"))
+}
+
+// ChangeHandle 형식 오류면 validator.Errors, 이미 사용 중(예약 기간 안의 다른 유저의 예전 handle 포함)이면 ErrHandleTaken
+// 변경 주기(handlePolicy.Cooldown) 안이면 ErrHandleChangeTooSoon
+func (s *ProfileService) ChangeHandle(ctx context.Context, userID int, handle string) (*model.User, error) {
+	handle = NormalizeHandle(handle)
+	var verrs validator.Errors
+	verrs.Add("handle", s.validator.ValidateHandle(handle))
+	if err := verrs.Err(); err != nil {
+		return nil, err
+	}
+	user, err := s.handleRepo.ChangeHandle(ctx, userID, handle, handlePolicy)
+	if errors.Is(err, repository.ErrConflict) {
+		return nil, ErrHandleTaken.Wrap(err)
+	} else if errors.Is(err, repository.ErrTooSoon) {
+		tooSoon := ErrHandleChangeTooSoon.Wrap(err)
+		if history, histErr := s.handleRepo.ListHistory(ctx, userID); histErr == nil && len(history) > 0 {
+			tooSoon = tooSoon.WithDetail("retry_at", history[0].ChangedAt.Add(handlePolicy.Cooldown))
+		}
+		return nil, tooSoon
+	}
+	return user, err
+}
+
+func (s *ProfileService) ListHandleHistory(ctx context.Context, userID int) ([]model.HandleChange, error) {
+	return s.handleRepo.ListHistory(ctx, userID)
+}
+
+// GetPublicProfile viewerID 는 비로그인이면 0
+// 탈퇴/영구 차단된 유저, viewer 와 차단 관계인 유저는 ErrNotFound
+// profile.visibility 범위 밖의 viewer 에게는 handle/닉네임/프로필 이미지만 공개 (restricted=true)
+// 예전 handle 은 같은 확인을 통과하고 공개 범위 안인 viewer 에게만 현재 handle 을 알려줌 (범위 밖이면 ErrNotFound)
+func (s *ProfileService) GetPublicProfile(ctx context.Context, handle string, viewerID int) (*PublicProfile, error) {
+	handle = NormalizeHandle(handle)
+	redirect := ""
+	owner, err := s.handleRepo.FindUserByHandle(ctx, handle)
+	if errors.Is(err, repository.ErrNotFound) {
+		current, histErr := s.handleRepo.FindCurrentHandle(ctx, handle, handlePolicy.Reservation)
+		if histErr != nil {
+			return nil, histErr
+		}
+		owner, err = s.handleRepo.FindUserByHandle(ctx, current)
+		if err != nil {
+			return nil, errors.Wrap(err, "[GetPublicProfile] find user by current handle failed")
+		}
+		redirect = current
+	} else if err != nil {
+		return nil, errors.Wrap(err, "[GetPublicProfile] find user by handle failed")
+	}
+	if owner.DeletedAt != nil || owner.Status == model.UserStatusBanned {
+		return nil, errors.Wrapf(repository.ErrNotFound, "[GetPublicProfile] user %d is not public", owner.ID)
+	}
+	if err := s.blockSvc.CheckInteraction(ctx, viewerID, owner.ID); errors.Is(err, ErrBlocked) {
+		return nil, errors.Wrapf(repository.ErrNotFound, "[GetPublicProfile] user %d is blocked with viewer %d", owner.ID, viewerID)
+	} else if err != nil {
+		return nil, errors.Wrap(err, "[GetPublicProfile] check block failed")
+	}
+
+	prefs, err := s.prefSvc.Get(ctx, owner.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "[GetPublicProfile] get preferences failed")
+	}
+	visible, err := s.canView(ctx, owner.ID, viewerID, prefs[preference.KeyProfileVisibility])
+	if err != nil {
+		return nil, errors.Wrap(err, "[GetPublicProfile] check visibility failed")
+	}
+	if redirect != "" {
+		if !visible {
+			return nil, errors.Wrapf(repository.ErrNotFound, "[GetPublicProfile] old handle of user %d is not visible to viewer %d", owner.ID, viewerID)
+		}
+		return &PublicProfile{RedirectHandle: redirect}, nil
+	}
+
+	fields := map[string]interface{}{
+		"id":            owner.ID,
+		"handle":        owner.Handle,
+		"nickname":      owner.Nickname,
+		"profile_image": owner.ProfileImage,
+	}
+	isOwner := owner.ID == viewerID
+	fields["restricted"] = !visible
+	if !visible {
+		return &PublicProfile{Fields: fields}, nil
+	}
+
+	if isOwner || prefs[preference.KeyProfileShowDisplayName] == true {
+		fields["display_name"] = owner.DisplayName
+	}
+	if isOwner || prefs[preference.KeyProfileShowBio] == true {
+		fields["bio"] = owner.Bio
+	}
+	for _, section := range s.sections {
+		data, err := section.Collect(ctx, owner, viewerID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "[GetPublicProfile] collect section failed (key=%s)", section.Key)
+		}
+		if data != nil {
+			fields[section.Key] = data
+		}
+	}
+	return &PublicProfile{Fields: fields}, nil
+}
+
+// canView profile.visibility: public(모두) / followers(승인된 팔로워) / private(본인만)
+func (s *ProfileService) canView(ctx context.Context, ownerID, viewerID int, visibility interface{}) (bool, error) {
+	if ownerID == viewerID {
+		return true, nil
+	}
+	switch visibility {
+	case preference.VisibilityPrivate:
+		return false, nil
+	case preference.VisibilityFollowers:
+		if viewerID == 0 {
+			return false, nil
+		}
+		return s.followSvc.IsFollowing(ctx, viewerID, ownerID)
+	default:
+		return true, nil
+	}
+}
+
+// ExportSection 개인정보 내보내기 아카이브에 handle 변경 이력 포함
+func (s *ProfileService) ExportSection() ExportSection {
+	return ExportSection{
+		FileName: "handles.json",
+		Collect: func(ctx context.Context, userID int) (interface{}, error) {
+			return s.handleRepo.ListHistory(ctx, userID)
+		},
+	}
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"server/internal/apperror"
+	"server/internal/model"
+	"server/internal/preference"
+	"server/internal/repository"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// 필요한 메서드만 구현 (나머지는 호출되면 nil 인터페이스로 panic)
+type fakeHandleRepo struct {
+	repository.HandleRepository
+	users   map[string]*model.User
+	history map[string]string // 예전 handle → 현재 handle
+
+	changeErr error
+	changes   []model.HandleChange // ListHistory 결과 (최근 변경이 먼저)
+	policy    model.HandlePolicy   // ChangeHandle 에 전달된 제한
+}
+
+func (r *fakeHandleRepo) ChangeHandle(ctx context.Context, userID int, handle string, policy model.HandlePolicy) (*model.User, error) {
+	r.policy = policy
+	if r.changeErr != nil {
+		return nil, r.changeErr
+	}
+	return &model.User{ID: userID, Handle: handle}, nil
+}
+
+func (r *fakeHandleRepo) ListHistory(ctx context.Context, userID int) ([]model.HandleChange, error) {
+	return r.changes, nil
+}
+
+func (r *fakeHandleRepo) FindUserByHandle(ctx context.Context, handle string) (*model.User, error) {
+	if u, ok := r.users[handle]; ok {
+		return u, nil
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r *fakeHandleRepo) FindCurrentHandle(ctx context.Context, oldHandle string, reservation time.Duration) (string, error) {
+	if h, ok := r.history[oldHandle]; ok {
+		return h, nil
+	}
+	return "", repository.ErrNotFound
+}
+
+type fakePreferenceRepo struct {
+	repository.PreferenceRepository
+	prefs map[string]json.RawMessage
+}
+
+func (r *fakePreferenceRepo) Get(ctx context.Context, userID int) (map[string]json.RawMessage, error) {
+	return r.prefs, nil
+}
+
+type fakeBlockRepo struct {
+	repository.BlockRepository
+	blocked bool
+}
+
+func (r *fakeBlockRepo) IsBlockedEither(ctx context.Context, userA, userB int) (bool, error) {
+	return r.blocked, nil
+}
+
+func TestGetPublicProfileOldHandle(t *testing.T) {
+	const ownerID, strangerID = 1, 2
+	now := time.Now()
+	tests := []struct {
+		name         string
+		mutate       func(u *model.User)
+		visibility   string
+		blocked      bool
+		viewerID     int
+		wantRedirect bool
+	}{
+		{name: "public", visibility: preference.VisibilityPublic, viewerID: strangerID, wantRedirect: true},
+		{name: "public, anonymous viewer", visibility: preference.VisibilityPublic, wantRedirect: true},
+		{name: "private, stranger", visibility: preference.VisibilityPrivate, viewerID: strangerID},
+		{name: "private, owner", visibility: preference.VisibilityPrivate, viewerID: ownerID, wantRedirect: true},
+		{name: "followers only, anonymous viewer", visibility: preference.VisibilityFollowers},
+		{name: "blocked viewer", visibility: preference.VisibilityPublic, blocked: true, viewerID: strangerID},
+		{name: "deleted owner", visibility: preference.VisibilityPublic, viewerID: strangerID, mutate: func(u *model.User) { u.DeletedAt = &now }},
+		{name: "banned owner", visibility: preference.VisibilityPublic, viewerID: strangerID, mutate: func(u *model.User) { u.Status = model.UserStatusBanned }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner := &model.User{ID: ownerID, Handle: "new", Status: model.UserStatusActive}
+			if tt.mutate != nil {
+				tt.mutate(owner)
+			}
+			visibility, _ := json.Marshal(tt.visibility)
+			handleRepo := &fakeHandleRepo{
+				users:   map[string]*model.User{"new": owner},
+				history: map[string]string{"old": "new"},
+			}
+			prefSvc := NewPreferenceService(preference.NewDefaultRegistry(), &fakePreferenceRepo{
+				prefs: map[string]json.RawMessage{preference.KeyProfileVisibility: visibility},
+			})
+			blockSvc := NewBlockService(nil, &fakeBlockRepo{blocked: tt.blocked})
+			s := NewProfileService(handleRepo, prefSvc, blockSvc, nil)
+
+			profile, err := s.GetPublicProfile(context.Background(), "@Old", tt.viewerID)
+			if !tt.wantRedirect {
+				if !errors.Is(err, repository.ErrNotFound) {
+					t.Fatalf("err = %v, profile = %+v, want ErrNotFound", err, profile)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetPublicProfile: %v", err)
+			}
+			if profile.RedirectHandle != "new" || profile.Fields != nil {
+				t.Errorf("profile = %+v, want redirect to new", profile)
+			}
+		})
+	}
+}
+
+func TestChangeHandleLimits(t *testing.T) {
+	lastChange := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		repoErr     error
+		wantErr     error
+		wantRetryAt *time.Time
+	}{
+		{name: "changed"},
+		{name: "reserved by another user", repoErr: repository.ErrConflict, wantErr: ErrHandleTaken},
+		{name: "within cooldown", repoErr: repository.ErrTooSoon, wantErr: ErrHandleChangeTooSoon,
+			wantRetryAt: ptrTime(lastChange.Add(handlePolicy.Cooldown))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeHandleRepo{
+				changeErr: tt.repoErr,
+				changes:   []model.HandleChange{{Handle: "old", ChangedAt: lastChange}},
+			}
+			s := NewProfileService(repo, nil, nil, nil)
+
+			user, err := s.ChangeHandle(context.Background(), 1, "@New_Handle")
+			if repo.policy != handlePolicy {
+				t.Errorf("policy = %+v, want %+v", repo.policy, handlePolicy)
+			}
+			if tt.wantErr == nil {
+				if err != nil || user.Handle != "new_handle" {
+					t.Fatalf("ChangeHandle = (%+v, %v), want handle new_handle", user, err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			var appErr *apperror.Error
+			if !errors.As(err, &appErr) {
+				t.Fatalf("err %v is not an apperror", err)
+			}
+			if tt.wantRetryAt != nil && appErr.Details["retry_at"] != *tt.wantRetryAt {
+				t.Errorf("retry_at = %v, want %v", appErr.Details["retry_at"], *tt.wantRetryAt)
+			}
+		})
+	}
+}
+
+func ptrTime(t time.Time) *time.Time { return &t }
@@ -2,20 +2,61 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"server/internal/apperror"
 	"server/internal/model"
+	"server/internal/pagination"
+	"server/internal/preference"
 	"server/internal/repository"
+	"server/pkg/hangul"
+	"server/pkg/validator"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
 )
 
 type UserService struct {
-	userRepo repository.UserRepository
+	userRepo  repository.UserRepository
+	prefSvc   *PreferenceService
+	validator *validator.Validator
+}
+
+func NewUserService(r repository.UserRepository, prefSvc *PreferenceService) *UserService {
+	return &UserService{
+		userRepo:  r,
+		prefSvc:   prefSvc,
+		validator: validator.NewValidator(),
+	}
+}
+
+// UserProfile 본인 프로필 (언어/타임존은 users 컬럼이 아니라 preference 키 locale, timezone 에 저장)
+type UserProfile struct {
+	*model.User
+	Locale   string
+	Timezone string
 }
 
-func NewUserService(r repository.UserRepository) *UserService {
-	return &UserService{userRepo: r}
+// ProfileUpdate 본인이 수정 가능한 프로필 항목 (nil 이면 변경하지 않음)
+type ProfileUpdate struct {
+	Nickname    *string
+	DisplayName *string
+	Bio         *string
+	Locale      *string // preference "locale"
+	Timezone    *string // preference "timezone"
+
+	// 클라이언트가 마지막으로 읽은 updated_at (있으면 그 사이 수정된 경우 repository.ErrConflict)
+	ExpectedUpdatedAt *time.Time
 }
 
-func (s *UserService) ListUsers(ctx context.Context) ([]model.User, error) {
-	return s.userRepo.ListAllUsers(ctx)
+// ListUsers 필터/정렬 조건으로 한 페이지 조회
+func (s *UserService) ListUsers(ctx context.Context, filter model.UserFilter, page pagination.Request) (pagination.Page[model.User], error) {
+	users, err := s.userRepo.ListUsers(ctx, filter, page)
+	if err != nil {
+		return pagination.Page[model.User]{}, errors.Wrap(err, "[ListUsers] list users failed")
+	}
+	return pagination.NewPage(users, page, repository.UserSortKey), nil
 }
 
 func (s *UserService) CreateUser(ctx context.Context, username string) (*model.User, error) {
@@ -26,7 +67,7 @@ func (s *UserService) CreateUser(ctx context.Context, username string) (*model.U
 		Name:          username,
 		Nickname:      username,
 		ProfileImage:  "",
-		Role:          "USER",
+		Role:          model.RoleUser,
 		VisitsCount:   1,
 	}
 	return s.userRepo.CreateUser(ctx, newUser)
@@ -35,3 +76,149 @@ func (s *UserService) CreateUser(ctx context.Context, username string) (*model.U
 func (s *UserService) FindByID(ctx context.Context, userID int) (*model.User, error) {
 	return s.userRepo.FindByID(ctx, userID)
 }
+
+// GetProfile 본인 프로필 (언어/타임존 포함)
+func (s *UserService) GetProfile(ctx context.Context, userID int) (*UserProfile, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "[GetProfile] find user failed")
+	}
+	return s.Profile(ctx, user)
+}
+
+// Profile 이미 조회한 유저에 언어/타임존 설정을 채움
+func (s *UserService) Profile(ctx context.Context, user *model.User) (*UserProfile, error) {
+	prefs, err := s.prefSvc.Get(ctx, user.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "[Profile] get preferences failed")
+	}
+	return newUserProfile(user, prefs), nil
+}
+
+func newUserProfile(user *model.User, prefs map[string]interface{}) *UserProfile {
+	locale, _ := prefs[preference.KeyLocale].(string)
+	timezone, _ := prefs[preference.KeyTimezone].(string)
+	return &UserProfile{User: user, Locale: locale, Timezone: timezone}
+}
+
+// UpdateProfile 필드별 검증 후 전달된 항목만 갱신 (언어/타임존은 PreferenceService 로 저장)
+// 검증 실패 시 validator.Errors 를 그대로 반환 (핸들러에서 필드별 오류 응답)
+func (s *UserService) UpdateProfile(ctx context.Context, userID int, req ProfileUpdate) (*UserProfile, error) {
+	// 앞뒤 공백은 입력 실수로 보고 제거 (소개는 사용자가 넣은 줄바꿈 유지)
+	trim(req.Nickname)
+	trim(req.DisplayName)
+	trim(req.Locale)
+	trim(req.Timezone)
+
+	var verrs validator.Errors
+	if req.Nickname != nil {
+		verrs.Add("nickname", s.validator.ValidateNickname(*req.Nickname))
+	}
+	if req.DisplayName != nil && *req.DisplayName != "" {
+		// 빈 문자열은 표시 이름 제거 (닉네임으로 표시)
+		verrs.Add("display_name", s.validator.ValidateDisplayName(*req.DisplayName))
+	}
+	if req.Bio != nil {
+		verrs.Add("bio", s.validator.ValidateBio(*req.Bio))
+	}
+	patch := make(map[string]json.RawMessage)
+	if req.Locale != nil {
+		verrs.Add("locale", s.validator.ValidateLocale(*req.Locale))
+		patch[preference.KeyLocale], _ = json.Marshal(*req.Locale)
+	}
+	if req.Timezone != nil {
+		verrs.Add("timezone", s.validator.ValidateTimezone(*req.Timezone))
+		patch[preference.KeyTimezone], _ = json.Marshal(*req.Timezone)
+	}
+	if err := verrs.Err(); err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.UpdateUser(ctx, userID, model.UserUpdate{
+		Nickname:          req.Nickname,
+		DisplayName:       req.DisplayName,
+		Bio:               req.Bio,
+		ExpectedUpdatedAt: req.ExpectedUpdatedAt,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "[UpdateProfile] update user failed")
+	}
+	// 프로필 수정이 충돌(409)로 실패하면 설정도 바꾸지 않도록 유저 갱신 후 저장
+	if len(patch) == 0 {
+		return s.Profile(ctx, user)
+	}
+	prefs, err := s.prefSvc.Update(ctx, userID, patch)
+	if err != nil {
+		return nil, errors.Wrap(err, "[UpdateProfile] update preferences failed")
+	}
+	return newUserProfile(user, prefs), nil
+}
+
+func trim(v *string) {
+	if v != nil {
+		*v = strings.TrimSpace(*v)
+	}
+}
+
+const (
+	searchQueryMaxLen  = 50
+	searchDefaultLimit = 20
+	searchMaxLimit     = 50
+)
+
+// ErrInvalidSearchQuery 검색어가 비었거나 너무 긴 경우
+var ErrInvalidSearchQuery = apperror.BadRequest("INVALID_SEARCH_QUERY", "검색어를 확인해주세요.")
+
+// UserSearchHit 검색 결과 + 필드별 일치 구간 (클라이언트 하이라이트용)
+type UserSearchHit struct {
+	User       model.User
+	Score      float64
+	Highlights map[string][]hangul.Range
+}
+
+// SearchUsers includePrivate 가 false 면 공개 필드(닉네임/표시 이름)만 검색하고 하이라이트
+// 관리자 검색이 아니면 viewerID 와 차단 관계인 유저는 결과에서 제외
+func (s *UserService) SearchUsers(ctx context.Context, viewerID int, query string, includePrivate bool, limit int) ([]UserSearchHit, error) {
+	query = strings.TrimSpace(query)
+	if query == "" || utf8.RuneCountInString(query) > searchQueryMaxLen {
+		return nil, errors.Wrapf(ErrInvalidSearchQuery, "query must be 1-%d characters", searchQueryMaxLen)
+	}
+	if limit <= 0 {
+		limit = searchDefaultLimit
+	} else if limit > searchMaxLimit {
+		limit = searchMaxLimit
+	}
+
+	search := model.UserSearch{
+		Query:          query,
+		IncludePrivate: includePrivate,
+		Limit:          limit,
+	}
+	if !includePrivate {
+		search.ViewerID = viewerID
+	}
+	results, err := s.userRepo.Search(ctx, search)
+	if err != nil {
+		return nil, errors.Wrap(err, "[SearchUsers] search failed")
+	}
+
+	hits := make([]UserSearchHit, 0, len(results))
+	for _, res := range results {
+		fields := map[string]string{
+			"nickname":     res.User.Nickname,
+			"display_name": res.User.DisplayName,
+		}
+		if includePrivate {
+			fields["name"] = res.User.Name
+			fields["email"] = res.User.Email
+		}
+		highlights := make(map[string][]hangul.Range)
+		for field, text := range fields {
+			if ranges := hangul.MatchRanges(text, query); len(ranges) > 0 {
+				highlights[field] = ranges
+			}
+		}
+		hits = append(hits, UserSearchHit{User: res.User, Score: res.Score, Highlights: highlights})
+	}
+	return hits, nil
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"server/internal/model"
+	"server/internal/preference"
+	"server/internal/repository"
+	"server/pkg/validator"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+type fakeUserRepo struct {
+	repository.UserRepository
+	user    model.User
+	updates []model.UserUpdate
+	err     error
+}
+
+func (r *fakeUserRepo) FindByID(ctx context.Context, id int) (*model.User, error) {
+	u := r.user
+	return &u, nil
+}
+
+func (r *fakeUserRepo) UpdateUser(ctx context.Context, id int, upd model.UserUpdate) (*model.User, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	r.updates = append(r.updates, upd)
+	if upd.Bio != nil {
+		r.user.Bio = *upd.Bio
+	}
+	u := r.user
+	return &u, nil
+}
+
+// mergingPreferenceRepo Merge 까지 흉내 내는 설정 저장소
+type mergingPreferenceRepo struct {
+	repository.PreferenceRepository
+	prefs map[string]json.RawMessage
+}
+
+func (r *mergingPreferenceRepo) Get(ctx context.Context, userID int) (map[string]json.RawMessage, error) {
+	return r.prefs, nil
+}
+
+func (r *mergingPreferenceRepo) Merge(ctx context.Context, userID int, set map[string]interface{}, unset []string) (map[string]json.RawMessage, error) {
+	for k, v := range set {
+		r.prefs[k], _ = json.Marshal(v)
+	}
+	for _, k := range unset {
+		delete(r.prefs, k)
+	}
+	return r.prefs, nil
+}
+
+func TestUpdateProfileLocaleTimezone(t *testing.T) {
+	str := func(s string) *string { return &s }
+	tests := []struct {
+		name         string
+		req          ProfileUpdate
+		repoErr      error
+		wantLocale   string
+		wantTimezone string
+		wantVerr     bool
+		wantErr      error
+	}{
+		{name: "defaults", req: ProfileUpdate{Bio: str("hi")}, wantLocale: "ko-KR", wantTimezone: "Asia/Seoul"},
+		{name: "locale and timezone", req: ProfileUpdate{Locale: str(" en-US "), Timezone: str("Europe/London")},
+			wantLocale: "en-US", wantTimezone: "Europe/London"},
+		{name: "invalid locale", req: ProfileUpdate{Locale: str("fr-FR")}, wantVerr: true},
+		{name: "invalid timezone", req: ProfileUpdate{Timezone: str("Mars/Olympus")}, wantVerr: true},
+		{name: "conflict keeps preferences", req: ProfileUpdate{Locale: str("ja-JP")}, repoErr: repository.ErrConflict,
+			wantErr: repository.ErrConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefRepo := &mergingPreferenceRepo{prefs: map[string]json.RawMessage{}}
+			userRepo := &fakeUserRepo{user: model.User{ID: 1}, err: tt.repoErr}
+			s := NewUserService(userRepo, NewPreferenceService(preference.NewDefaultRegistry(), prefRepo))
+
+			profile, err := s.UpdateProfile(context.Background(), 1, tt.req)
+			if tt.wantVerr {
+				var verrs validator.Errors
+				if !errors.As(err, &verrs) {
+					t.Fatalf("err = %v, want validator.Errors", err)
+				}
+				if len(prefRepo.prefs) != 0 || len(userRepo.updates) != 0 {
+					t.Errorf("stored after validation failure: prefs=%v updates=%v", prefRepo.prefs, userRepo.updates)
+				}
+				return
+			}
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				if len(prefRepo.prefs) != 0 {
+					t.Errorf("preferences changed on failed update: %v", prefRepo.prefs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UpdateProfile: %v", err)
+			}
+			if profile.Locale != tt.wantLocale || profile.Timezone != tt.wantTimezone {
+				t.Errorf("profile locale/timezone = %q/%q, want %q/%q", profile.Locale, profile.Timezone, tt.wantLocale, tt.wantTimezone)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"server/internal/apperror"
+	"server/internal/config"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+// ErrObjectNotFound 요청한 키의 객체가 없을 때 (핸들러에서 404 분기용)
+var ErrObjectNotFound = apperror.NotFound("OBJECT_NOT_FOUND", "파일을 찾을 수 없습니다.")
+
+// PrivatePrefix 이 접두사 아래 객체는 MediaHandler/공개 URL 로 내려주지 않음 (소유자 확인 후 서비스가 직접 스트리밍)
+const PrivatePrefix = "private/"
+
+// IsPrivate 비공개 객체 키인지
+func IsPrivate(key string) bool {
+	return strings.HasPrefix(key, PrivatePrefix)
+}
+
+// ObjectInfo 조회한 객체의 메타데이터
+type ObjectInfo struct {
+	ContentType string
+	Size        int64
+}
+
+// BlobStore 업로드 파일(프로필 이미지 등) 저장소
+// 키는 "avatars/12/ab12cd/256.jpg" 처럼 '/' 로 구분한 상대 경로
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, *ObjectInfo, error)
+	Delete(ctx context.Context, key string) error
+	// URL 클라이언트가 객체를 내려받을 공개 URL
+	URL(key string) string
+}
+
+// NewBlobStore storage.driver 설정에 따라 구현체 생성
+func NewBlobStore(cfg *config.AppConfig) (BlobStore, error) {
+	switch cfg.Storage.Driver {
+	case "", DriverLocal:
+		return NewLocalBlobStore(cfg.Storage.LocalDir, cfg.StoragePublicBaseURL())
+	case DriverS3:
+		return NewS3BlobStore(S3Options{
+			Endpoint:      cfg.Storage.S3.Endpoint,
+			Region:        cfg.Storage.S3.Region,
+			Bucket:        cfg.Storage.S3.Bucket,
+			AccessKey:     cfg.StorageS3AccessKey(),
+			SecretKey:     cfg.StorageS3SecretKey(),
+			UsePathStyle:  cfg.Storage.S3.UsePathStyle,
+			PublicBaseURL: cfg.Storage.PublicBaseURL,
+		})
+	default:
+		return nil, errors.Errorf("[NewBlobStore] unknown storage driver: %s", cfg.Storage.Driver)
+	}
+}
+
+// validateKey 경로 조작(../, 절대 경로) 방지
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return errors.Errorf("invalid object key: %q", key)
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return errors.Errorf("invalid object key: %q", key)
+		}
+	}
+	return nil
+}
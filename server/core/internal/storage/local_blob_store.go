@@ -0,0 +1,103 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// LocalBlobStore 로컬 파일시스템 저장소 (local 환경, 단일 인스턴스용)
+// 파일은 GET /api/v1/media/{key...} 로 서빙
+type LocalBlobStore struct {
+	baseDir       string
+	publicBaseURL string
+}
+
+func NewLocalBlobStore(baseDir, publicBaseURL string) (*LocalBlobStore, error) {
+	if baseDir == "" {
+		return nil, errors.New("[NewLocalBlobStore] storage.local_dir is empty")
+	}
+	if err := os.MkdirAll(baseDir, 0o755); err != nil {
+		return nil, errors.Wrapf(err, "[NewLocalBlobStore] mkdir failed (dir=%s)", baseDir)
+	}
+	return &LocalBlobStore{
+		baseDir:       baseDir,
+		publicBaseURL: strings.TrimSuffix(publicBaseURL, "/"),
+	}, nil
+}
+
+// Put 임시 파일에 쓴 뒤 rename 하여 읽는 쪽에서 반쯤 쓰인 파일을 보지 않도록 함
+func (s *LocalBlobStore) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return errors.Wrap(err, "[LocalBlobStore.Put]")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return errors.Wrapf(err, "[LocalBlobStore.Put] mkdir failed (key=%s)", key)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return errors.Wrapf(err, "[LocalBlobStore.Put] write failed (key=%s)", key)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return errors.Wrapf(err, "[LocalBlobStore.Put] rename failed (key=%s)", key)
+	}
+	return nil
+}
+
+func (s *LocalBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, *ObjectInfo, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, nil, errors.Wrap(ErrObjectNotFound, err.Error())
+	}
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil, errors.Wrapf(ErrObjectNotFound, "[LocalBlobStore.Get] key=%s", key)
+	} else if err != nil {
+		return nil, nil, errors.Wrapf(err, "[LocalBlobStore.Get] open failed (key=%s)", key)
+	}
+	stat, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, nil, errors.Wrapf(err, "[LocalBlobStore.Get] stat failed (key=%s)", key)
+	}
+	if stat.IsDir() {
+		_ = f.Close()
+		return nil, nil, errors.Wrapf(ErrObjectNotFound, "[LocalBlobStore.Get] key=%s is a directory", key)
+	}
+
+	contentType := mime.TypeByExtension(filepath.Ext(path))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	return f, &ObjectInfo{ContentType: contentType, Size: stat.Size()}, nil
+}
+
+// Delete 없는 키는 성공으로 처리
+func (s *LocalBlobStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return errors.Wrap(err, "[LocalBlobStore.Delete]")
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "[LocalBlobStore.Delete] remove failed (key=%s)", key)
+	}
+	return nil
+}
+
+func (s *LocalBlobStore) URL(key string) string {
+	return s.publicBaseURL + "/" + key
+}
+
+func (s *LocalBlobStore) path(key string) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.baseDir, filepath.FromSlash(key)), nil
+}
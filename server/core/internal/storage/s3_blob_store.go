@@ -0,0 +1,242 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	s3Service        = "s3"
+	s3SignAlgorithm  = "AWS4-HMAC-SHA256"
+	s3RequestTimeout = 30 * time.Second
+)
+
+// S3Options S3 호환 저장소(AWS S3, MinIO 등) 접속 정보
+type S3Options struct {
+	Endpoint  string // 예) https://s3.ap-northeast-2.amazonaws.com, http://localhost:9000
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	// MinIO 등은 가상 호스트(bucket.host) 대신 경로 방식(host/bucket/key) 사용
+	UsePathStyle bool
+	// CDN 등 공개 주소 (비어 있으면 버킷 주소를 그대로 사용)
+	PublicBaseURL string
+}
+
+// S3BlobStore AWS Signature V4 로 서명한 REST 요청으로 객체 저장
+// SDK 의존성 없이 Put/Get/Delete 만 구현
+type S3BlobStore struct {
+	opts     S3Options
+	endpoint *url.URL
+	client   *http.Client
+	now      func() time.Time
+}
+
+func NewS3BlobStore(opts S3Options) (*S3BlobStore, error) {
+	if opts.Endpoint == "" || opts.Bucket == "" || opts.Region == "" {
+		return nil, errors.New("[NewS3BlobStore] storage.s3 endpoint/region/bucket are required")
+	}
+	if opts.AccessKey == "" || opts.SecretKey == "" {
+		return nil, errors.New("[NewS3BlobStore] S3 access key / secret key are empty")
+	}
+	endpoint, err := url.Parse(strings.TrimSuffix(opts.Endpoint, "/"))
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, errors.Errorf("[NewS3BlobStore] invalid endpoint: %s", opts.Endpoint)
+	}
+	return &S3BlobStore{
+		opts:     opts,
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: s3RequestTimeout},
+		now:      time.Now,
+	}, nil
+}
+
+func (s *S3BlobStore) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	if err := validateKey(key); err != nil {
+		return errors.Wrap(err, "[S3BlobStore.Put]")
+	}
+	headers := http.Header{}
+	headers.Set("Content-Type", contentType)
+
+	resp, err := s.do(ctx, http.MethodPut, key, data, headers)
+	if err != nil {
+		return errors.Wrapf(err, "[S3BlobStore.Put] request failed (key=%s)", key)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("[S3BlobStore.Put] unexpected status %d (key=%s): %s", resp.StatusCode, key, readErrorBody(resp))
+	}
+	return nil
+}
+
+func (s *S3BlobStore) Get(ctx context.Context, key string) (io.ReadCloser, *ObjectInfo, error) {
+	if err := validateKey(key); err != nil {
+		return nil, nil, errors.Wrap(ErrObjectNotFound, err.Error())
+	}
+	resp, err := s.do(ctx, http.MethodGet, key, nil, nil)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "[S3BlobStore.Get] request failed (key=%s)", key)
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, nil, errors.Wrapf(ErrObjectNotFound, "[S3BlobStore.Get] key=%s", key)
+	default:
+		defer resp.Body.Close()
+		return nil, nil, errors.Errorf("[S3BlobStore.Get] unexpected status %d (key=%s): %s", resp.StatusCode, key, readErrorBody(resp))
+	}
+
+	size, _ := strconv.ParseInt(resp.Header.Get("Content-Length"), 10, 64)
+	return resp.Body, &ObjectInfo{
+		ContentType: resp.Header.Get("Content-Type"),
+		Size:        size,
+	}, nil
+}
+
+// Delete S3 는 없는 키 삭제도 204 를 반환
+func (s *S3BlobStore) Delete(ctx context.Context, key string) error {
+	if err := validateKey(key); err != nil {
+		return errors.Wrap(err, "[S3BlobStore.Delete]")
+	}
+	resp, err := s.do(ctx, http.MethodDelete, key, nil, nil)
+	if err != nil {
+		return errors.Wrapf(err, "[S3BlobStore.Delete] request failed (key=%s)", key)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return errors.Errorf("[S3BlobStore.Delete] unexpected status %d (key=%s): %s", resp.StatusCode, key, readErrorBody(resp))
+	}
+	return nil
+}
+
+func (s *S3BlobStore) URL(key string) string {
+	if s.opts.PublicBaseURL != "" {
+		return strings.TrimSuffix(s.opts.PublicBaseURL, "/") + "/" + key
+	}
+	return s.objectURL(key).String()
+}
+
+// objectURL 경로 방식: {endpoint}/{bucket}/{key}, 가상 호스트 방식: {scheme}://{bucket}.{host}/{key}
+func (s *S3BlobStore) objectURL(key string) *url.URL {
+	u := *s.endpoint
+	if s.opts.UsePathStyle {
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/" + s.opts.Bucket + "/" + key
+	} else {
+		u.Host = s.opts.Bucket + "." + u.Host
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/" + key
+	}
+	u.RawPath = s3EscapePath(u.Path)
+	return &u
+}
+
+func (s *S3BlobStore) do(ctx context.Context, method, key string, body []byte, headers http.Header) (*http.Response, error) {
+	u := s.objectURL(key)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "[S3BlobStore.do] new request failed")
+	}
+	for k, v := range headers {
+		req.Header[k] = v
+	}
+	req.ContentLength = int64(len(body))
+	s.sign(req, body)
+	return s.client.Do(req)
+}
+
+// sign AWS Signature Version 4 (Authorization 헤더 방식)
+// https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
+func (s *S3BlobStore) sign(req *http.Request, body []byte) {
+	now := s.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("Host", req.URL.Host)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := []string{"host", "x-amz-content-sha256", "x-amz-date"}
+	if req.Header.Get("Content-Type") != "" {
+		signedHeaders = append([]string{"content-type"}, signedHeaders...)
+	}
+	var canonicalHeaders strings.Builder
+	for _, h := range signedHeaders {
+		value := req.Header.Get(h)
+		if h == "host" {
+			value = req.URL.Host
+		}
+		canonicalHeaders.WriteString(h + ":" + strings.TrimSpace(value) + "\n")
+	}
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		strings.Join(signedHeaders, ";"),
+		payloadHash,
+	}, "\n")
+
+	scope := fmt.Sprintf("%s/%s/%s/aws4_request", date, s.opts.Region, s3Service)
+	stringToSign := strings.Join([]string{
+		s3SignAlgorithm,
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.opts.SecretKey), date)
+	signingKey = hmacSHA256(signingKey, s.opts.Region)
+	signingKey = hmacSHA256(signingKey, s3Service)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3SignAlgorithm, s.opts.AccessKey, scope, strings.Join(signedHeaders, ";"), signature))
+}
+
+// s3EscapePath SigV4 규칙: 비예약 문자(A-Z a-z 0-9 - _ . ~)와 '/' 외에는 모두 %XX 인코딩
+func s3EscapePath(path string) string {
+	var b strings.Builder
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || c == '/' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// readErrorBody S3 오류 응답(XML) 일부를 로그용으로 읽음
+func readErrorBody(resp *http.Response) string {
+	b, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return string(b)
+}
@@ -0,0 +1,24 @@
+-- 회원 탈퇴: soft delete 후 유예 기간(purge_after)이 지나면 개인정보 익명화
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS deleted_at    TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS purge_after   TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS anonymized_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_users_purge_after
+    ON users (purge_after)
+    WHERE deleted_at IS NOT NULL AND anonymized_at IS NULL;
+
+-- OAuth 제공자 계정 연결 정보 (탈퇴 시 unlink API 호출에 사용)
+CREATE TABLE IF NOT EXISTS user_identities (
+    id               SERIAL PRIMARY KEY,
+    user_id          INT          NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    provider         VARCHAR(50)  NOT NULL,   -- google / kakao / naver
+    provider_user_id VARCHAR(255) NOT NULL,
+    access_token     TEXT,
+    refresh_token    TEXT,
+    created_at       TIMESTAMP    NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMP    NOT NULL DEFAULT NOW(),
+    UNIQUE (provider, provider_user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_identities_user_id ON user_identities (user_id);
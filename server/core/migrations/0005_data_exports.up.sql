@@ -0,0 +1,15 @@
+-- 개인정보 열람(내보내기) 요청: 백그라운드 작업으로 ZIP 아카이브 생성
+CREATE TABLE IF NOT EXISTS data_exports (
+    id            SERIAL PRIMARY KEY,
+    user_id       INT         NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status        VARCHAR(20) NOT NULL DEFAULT 'PENDING', -- PENDING / RUNNING / READY / FAILED / EXPIRED
+    file_path     TEXT,
+    file_size     BIGINT,
+    error_message TEXT,
+    expires_at    TIMESTAMP,
+    created_at    TIMESTAMP   NOT NULL DEFAULT NOW(),
+    completed_at  TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_data_exports_user_id ON data_exports (user_id);
+CREATE INDEX IF NOT EXISTS idx_data_exports_status ON data_exports (status);
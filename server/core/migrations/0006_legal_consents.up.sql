@@ -0,0 +1,42 @@
+-- 약관/개인정보 처리방침 등 법적 문서 버전 관리
+CREATE TABLE IF NOT EXISTS legal_documents (
+    id           SERIAL PRIMARY KEY,
+    doc_type     VARCHAR(50)  NOT NULL,   -- TERMS_OF_SERVICE / PRIVACY_POLICY / MARKETING
+    version      VARCHAR(20)  NOT NULL,
+    title        VARCHAR(255) NOT NULL,
+    content_url  TEXT         NOT NULL,
+    mandatory    BOOLEAN      NOT NULL DEFAULT TRUE,
+    effective_at TIMESTAMP    NOT NULL DEFAULT NOW(),
+    created_at   TIMESTAMP    NOT NULL DEFAULT NOW(),
+    UNIQUE (doc_type, version)
+);
+
+-- 유저별 필수 문서 동의 기록 (어떤 버전에 언제 동의했는지)
+CREATE TABLE IF NOT EXISTS user_consents (
+    id          SERIAL PRIMARY KEY,
+    user_id     INT       NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    document_id INT       NOT NULL REFERENCES legal_documents(id),
+    agreed_at   TIMESTAMP NOT NULL DEFAULT NOW(),
+    ip_address  VARCHAR(64),
+    user_agent  TEXT,
+    UNIQUE (user_id, document_id)
+);
+
+-- 마케팅 수신 동의는 선택 항목이라 별도 이력으로 관리 (가장 최근 행이 현재 상태)
+CREATE TABLE IF NOT EXISTS marketing_consents (
+    id          SERIAL PRIMARY KEY,
+    user_id     INT       NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    document_id INT       REFERENCES legal_documents(id),
+    agreed      BOOLEAN   NOT NULL,
+    ip_address  VARCHAR(64),
+    user_agent  TEXT,
+    created_at  TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_marketing_consents_user_id ON marketing_consents (user_id, created_at DESC);
+
+INSERT INTO legal_documents (doc_type, version, title, content_url, mandatory)
+VALUES ('TERMS_OF_SERVICE', '1.0', '서비스 이용약관', '/legal/terms/1.0', TRUE),
+       ('PRIVACY_POLICY', '1.0', '개인정보 처리방침', '/legal/privacy/1.0', TRUE),
+       ('MARKETING', '1.0', '마케팅 정보 수신 동의', '/legal/marketing/1.0', FALSE)
+ON CONFLICT (doc_type, version) DO NOTHING;
@@ -0,0 +1,6 @@
+-- 프로필 편집 항목
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS display_name VARCHAR(50) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS bio          TEXT        NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS locale       VARCHAR(20) NOT NULL DEFAULT 'ko-KR',
+    ADD COLUMN IF NOT EXISTS timezone     VARCHAR(64) NOT NULL DEFAULT 'Asia/Seoul';
@@ -0,0 +1,4 @@
+-- 직접 업로드(또는 제공자 이미지를 복사)한 프로필 이미지의 저장소 키 접두사
+-- 비어 있으면 profile_image 는 OAuth 제공자 URL (만료될 수 있음)
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS avatar_key VARCHAR(255) NOT NULL DEFAULT '';
@@ -0,0 +1,6 @@
+-- 유저 목록 keyset 페이지네이션 (정렬 컬럼 + id) 및 prefix 검색
+CREATE INDEX IF NOT EXISTS idx_users_created_at_id     ON users (created_at, id);
+CREATE INDEX IF NOT EXISTS idx_users_nickname_id       ON users (nickname, id);
+CREATE INDEX IF NOT EXISTS idx_users_visits_count_id   ON users (visits_count, id);
+CREATE INDEX IF NOT EXISTS idx_users_email_prefix      ON users (lower(email) text_pattern_ops);
+CREATE INDEX IF NOT EXISTS idx_users_nickname_prefix   ON users (nickname text_pattern_ops);
@@ -0,0 +1,38 @@
+-- 유저 검색: pg_trgm 부분 일치/유사도 + 한글 초성 검색
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- 완성형 음절을 초성으로 변환 ("홍길동" → "ㅎㄱㄷ"), 나머지 문자는 소문자
+-- pkg/hangul.Choseong 과 같은 규칙 (생성 컬럼에 쓰기 위해 IMMUTABLE)
+CREATE OR REPLACE FUNCTION hangul_choseong(input TEXT) RETURNS TEXT
+    LANGUAGE plpgsql IMMUTABLE STRICT PARALLEL SAFE AS
+$$
+DECLARE
+    initials CONSTANT TEXT := 'ㄱㄲㄴㄷㄸㄹㅁㅂㅃㅅㅆㅇㅈㅉㅊㅋㅌㅍㅎ';
+    result TEXT := '';
+    ch TEXT;
+    code INT;
+BEGIN
+    FOR i IN 1..char_length(input) LOOP
+        ch := substr(input, i, 1);
+        code := ascii(ch);
+        IF code BETWEEN 44032 AND 55203 THEN
+            result := result || substr(initials, (code - 44032) / 588 + 1, 1);
+        ELSE
+            result := result || lower(ch);
+        END IF;
+    END LOOP;
+    RETURN result;
+END
+$$;
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS nickname_choseong     TEXT GENERATED ALWAYS AS (hangul_choseong(nickname)) STORED,
+    ADD COLUMN IF NOT EXISTS display_name_choseong TEXT GENERATED ALWAYS AS (hangul_choseong(display_name)) STORED;
+
+-- ILIKE '%q%' 및 similarity(%) 연산자 가속
+CREATE INDEX IF NOT EXISTS idx_users_nickname_trgm              ON users USING gin (nickname gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_display_name_trgm          ON users USING gin (display_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_name_trgm                  ON users USING gin (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_email_trgm                 ON users USING gin (lower(email) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_nickname_choseong_trgm     ON users USING gin (nickname_choseong gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_display_name_choseong_trgm ON users USING gin (display_name_choseong gin_trgm_ops);
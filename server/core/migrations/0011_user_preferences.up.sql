@@ -0,0 +1,6 @@
+-- 유저 설정: 키 스키마/기본값은 internal/preference 레지스트리에서 관리하고, 유저가 바꾼 값만 저장
+CREATE TABLE IF NOT EXISTS user_preferences (
+    user_id    INT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    prefs      JSONB     NOT NULL DEFAULT '{}'::jsonb,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
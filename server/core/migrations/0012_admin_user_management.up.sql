@@ -0,0 +1,27 @@
+-- 관리자 유저 관리: 계정 상태(정지/영구 차단), 조치 이력, 내부 메모
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS status          VARCHAR(20) NOT NULL DEFAULT 'ACTIVE', -- ACTIVE / SUSPENDED / BANNED
+    ADD COLUMN IF NOT EXISTS suspended_until TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS status_reason   TEXT        NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS admin_actions (
+    id             SERIAL PRIMARY KEY,
+    admin_id       INT         NOT NULL REFERENCES users(id),
+    target_user_id INT         NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    action         VARCHAR(30) NOT NULL, -- SUSPEND / BAN / REINSTATE / CHANGE_ROLE / ADD_NOTE
+    reason         TEXT        NOT NULL,
+    details        JSONB       NOT NULL DEFAULT '{}'::jsonb,
+    created_at     TIMESTAMP   NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_admin_actions_target_user_id ON admin_actions (target_user_id, created_at DESC);
+
+CREATE TABLE IF NOT EXISTS admin_user_notes (
+    id         SERIAL PRIMARY KEY,
+    user_id    INT       NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    admin_id   INT       NOT NULL REFERENCES users(id),
+    note       TEXT      NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_admin_user_notes_user_id ON admin_user_notes (user_id, created_at DESC);
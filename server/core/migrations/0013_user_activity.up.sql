@@ -0,0 +1,23 @@
+-- 로그인/활동 추적
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS last_login_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS last_seen_at  TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS onboarded_at  TIMESTAMP; -- NULL 이면 온보딩 미완료 (첫 로그인 유저)
+
+-- 기존 유저는 이미 서비스를 이용 중이므로 온보딩 완료로 간주
+UPDATE users
+   SET last_login_at = COALESCE(last_login_at, updated_at),
+       onboarded_at  = COALESCE(onboarded_at, created_at)
+ WHERE deleted_at IS NULL;
+
+-- 유저별 일일 활동 (DAU/WAU/MAU 집계용), activity_date 는 서비스 기준 시간대(Asia/Seoul) 날짜
+CREATE TABLE IF NOT EXISTS user_daily_activity (
+    user_id       INT       NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    activity_date DATE      NOT NULL,
+    first_seen_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    last_seen_at  TIMESTAMP NOT NULL DEFAULT NOW(),
+    login_count   INT       NOT NULL DEFAULT 0,
+    PRIMARY KEY (user_id, activity_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_daily_activity_date ON user_daily_activity (activity_date, user_id);
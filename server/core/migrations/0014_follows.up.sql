@@ -0,0 +1,15 @@
+-- 유저 간 팔로우 관계 (팔로우 승인제를 켠 유저에게는 PENDING 으로 생성 후 승인 시 ACCEPTED)
+CREATE TABLE IF NOT EXISTS follows (
+    id          SERIAL PRIMARY KEY,
+    follower_id INT         NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    followee_id INT         NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status      VARCHAR(20) NOT NULL DEFAULT 'ACCEPTED', -- PENDING / ACCEPTED
+    created_at  TIMESTAMP   NOT NULL DEFAULT NOW(),
+    accepted_at TIMESTAMP,
+    UNIQUE (follower_id, followee_id),
+    CHECK (follower_id <> followee_id)
+);
+
+-- 팔로워 목록 / 팔로잉 목록 keyset 페이지네이션 및 수 집계용
+CREATE INDEX IF NOT EXISTS idx_follows_followee ON follows (followee_id, status, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_follows_follower ON follows (follower_id, status, created_at DESC, id DESC);
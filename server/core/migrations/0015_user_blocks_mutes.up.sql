@@ -0,0 +1,25 @@
+-- 차단: 서로의 콘텐츠를 숨기고 팔로우/댓글 등 상호작용을 막음
+CREATE TABLE IF NOT EXISTS user_blocks (
+    id         SERIAL PRIMARY KEY,
+    blocker_id INT       NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    blocked_id INT       NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (blocker_id, blocked_id),
+    CHECK (blocker_id <> blocked_id)
+);
+
+-- 양방향 확인(NotBlockedCondition)에서 blocked_id 쪽으로도 조회
+CREATE INDEX IF NOT EXISTS idx_user_blocks_blocked ON user_blocks (blocked_id, blocker_id);
+CREATE INDEX IF NOT EXISTS idx_user_blocks_blocker_created ON user_blocks (blocker_id, created_at DESC, id DESC);
+
+-- 뮤트: 피드에서만 숨김 (상대는 알 수 없고 상호작용은 허용)
+CREATE TABLE IF NOT EXISTS user_mutes (
+    id         SERIAL PRIMARY KEY,
+    muter_id   INT       NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    muted_id   INT       NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (muter_id, muted_id),
+    CHECK (muter_id <> muted_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_mutes_muter_created ON user_mutes (muter_id, created_at DESC, id DESC);
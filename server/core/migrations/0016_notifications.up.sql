@@ -0,0 +1,23 @@
+-- 인앱 알림함
+-- group_key 가 같은 읽지 않은 알림은 한 행으로 묶고 group_count 를 늘림 (예: "새 팔로워 외 3명")
+CREATE TABLE IF NOT EXISTS notifications (
+    id          SERIAL PRIMARY KEY,
+    user_id     INT         NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type        VARCHAR(50) NOT NULL, -- follow.new_follower / follow.request / comment.new / security.event ...
+    actor_id    INT         REFERENCES users(id) ON DELETE SET NULL,
+    payload     JSONB       NOT NULL DEFAULT '{}'::jsonb,
+    group_key   VARCHAR(100) NOT NULL DEFAULT '',
+    group_count INT         NOT NULL DEFAULT 1,
+    read_at     TIMESTAMP,
+    created_at  TIMESTAMP   NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMP   NOT NULL DEFAULT NOW()
+);
+
+-- 읽지 않은 알림끼리만 묶음 (읽은 뒤 같은 종류가 오면 새 행)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_notifications_unread_group
+    ON notifications (user_id, group_key)
+    WHERE read_at IS NULL AND group_key <> '';
+
+-- 목록 keyset 페이지네이션 / 안 읽은 수 집계
+CREATE INDEX IF NOT EXISTS idx_notifications_user_updated ON notifications (user_id, updated_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_notifications_user_unread ON notifications (user_id) WHERE read_at IS NULL;
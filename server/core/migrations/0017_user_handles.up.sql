@@ -0,0 +1,34 @@
+-- 공개 프로필 URL 용 @handle (소문자/숫자/밑줄, 3~30자)
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS handle VARCHAR(30);
+
+-- 기존 유저는 user{id} 로 채움 (user + 숫자 형식은 자동 생성 전용으로 예약)
+UPDATE users SET handle = 'user' || id WHERE handle IS NULL;
+
+-- 새 유저도 INSERT 시 handle 을 지정하지 않으면 user{id} (BEFORE 트리거 시점에는 id 기본값이 이미 채워져 있음)
+CREATE OR REPLACE FUNCTION users_default_handle() RETURNS trigger AS $$
+BEGIN
+    IF NEW.handle IS NULL OR NEW.handle = '' THEN
+        NEW.handle := 'user' || NEW.id;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_users_default_handle ON users;
+CREATE TRIGGER trg_users_default_handle
+    BEFORE INSERT ON users
+    FOR EACH ROW EXECUTE FUNCTION users_default_handle();
+
+ALTER TABLE users ALTER COLUMN handle SET NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_handle ON users (handle);
+
+-- 이전 handle → 유저 (예전 URL 을 현재 handle 로 리다이렉트, 다른 유저가 가져가지 못하도록 예약)
+CREATE TABLE IF NOT EXISTS user_handle_history (
+    id         SERIAL PRIMARY KEY,
+    user_id    INT         NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    handle     VARCHAR(30) NOT NULL UNIQUE,
+    changed_at TIMESTAMP   NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_handle_history_user_id ON user_handle_history (user_id, changed_at DESC);
@@ -0,0 +1,9 @@
+-- rate limit 버킷 (rate_limit.backend=postgres, ECS 태스크 간 한도 공유)
+-- key: {정책}:{ip|user|api_key}:{값}, tat: 다음 요청의 이론적 도착 시각 (GCRA), 현재보다 과거면 버킷이 가득 찬 상태
+-- 유실돼도 한도가 초기화될 뿐이라 WAL 을 남기지 않는 UNLOGGED 테이블로 생성
+CREATE UNLOGGED TABLE IF NOT EXISTS rate_limit_buckets (
+    key TEXT      PRIMARY KEY,
+    tat TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_rate_limit_buckets_tat ON rate_limit_buckets (tat);
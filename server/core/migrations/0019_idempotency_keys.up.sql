@@ -0,0 +1,15 @@
+-- Idempotency-Key 요청 기록 (같은 key 로 재시도하면 저장한 응답을 그대로 재전송)
+-- key: {user|ip}:{값}:{Idempotency-Key}, fingerprint: 메서드 + 경로 + 본문 해시 (같은 key 를 다른 요청에 쓰면 422)
+-- status_code 가 NULL 이면 처리 중 (locked_until 이 지나면 인스턴스가 응답 전에 죽은 것으로 보고 다시 처리)
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    key             TEXT      PRIMARY KEY,
+    fingerprint     TEXT      NOT NULL,
+    status_code     INT,
+    response_header JSONB,
+    response_body   BYTEA,
+    locked_until    TIMESTAMP NOT NULL,
+    expires_at      TIMESTAMP NOT NULL,
+    created_at      TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);
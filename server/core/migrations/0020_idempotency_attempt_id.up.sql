@@ -0,0 +1,2 @@
+-- 처리 중인 key 를 선점한 요청 식별자 (잠금이 풀려 재시도가 넘겨받은 뒤 원래 요청이 응답을 저장/삭제하지 못하도록)
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS attempt_id TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+-- 생성 작업을 넘겨받은 인스턴스의 점유 기한 (여러 인스턴스가 같은 요청을 동시에 생성하지 않도록, 기한이 지나면 다른 인스턴스가 재개)
+ALTER TABLE data_exports ADD COLUMN IF NOT EXISTS lease_until TIMESTAMP;
@@ -0,0 +1,13 @@
+-- 언어/타임존은 유저 설정(preference 키 locale, timezone)으로 관리
+-- 기본값(ko-KR, Asia/Seoul)이 아닌 값만 user_preferences 로 옮김
+-- users.locale, users.timezone 컬럼은 더 이상 읽지 않지만 되돌릴 수 있도록 남겨 둠 (클라이언트 이전 후 별도 마이그레이션으로 제거)
+INSERT INTO user_preferences (user_id, prefs)
+SELECT id,
+       jsonb_strip_nulls(jsonb_build_object(
+           'locale',   NULLIF(locale, 'ko-KR'),
+           'timezone', NULLIF(timezone, 'Asia/Seoul')))
+FROM users
+WHERE locale <> 'ko-KR' OR timezone <> 'Asia/Seoul'
+ON CONFLICT (user_id) DO UPDATE
+    SET prefs      = user_preferences.prefs || EXCLUDED.prefs,
+        updated_at = NOW();
@@ -0,0 +1,8 @@
+-- 내보내기 아카이브를 인스턴스 로컬 파일 대신 BlobStore(private/exports/...)에 저장
+ALTER TABLE data_exports RENAME COLUMN file_path TO file_key;
+
+-- 기존 READY 요청은 로컬 경로를 가리키므로 만료 처리 (다시 요청하면 새로 생성)
+UPDATE data_exports
+   SET status   = 'EXPIRED',
+       file_key = NULL
+ WHERE file_key IS NOT NULL;
@@ -0,0 +1,107 @@
+package hangul
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	syllableBase  = 0xAC00 // '가'
+	syllableLast  = 0xD7A3 // '힣'
+	syllableBlock = 21 * 28
+)
+
+// 초성 19자 (한글 호환 자모)
+var choseong = []rune("ㄱㄲㄴㄷㄸㄹㅁㅂㅃㅅㅆㅇㅈㅉㅊㅋㅌㅍㅎ")
+
+// IsSyllable 완성형 한글 음절 (가-힣)
+func IsSyllable(r rune) bool {
+	return r >= syllableBase && r <= syllableLast
+}
+
+// IsChoseong 초성으로 쓸 수 있는 호환 자모 (ㄱ, ㄲ, ㄴ ...)
+func IsChoseong(r rune) bool {
+	for _, c := range choseong {
+		if c == r {
+			return true
+		}
+	}
+	return false
+}
+
+// IsChoseongOnly 공백을 제외한 모든 문자가 초성인지 (예: "ㄱㄷ" → 초성 검색)
+func IsChoseongOnly(s string) bool {
+	found := false
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			continue
+		}
+		if !IsChoseong(r) {
+			return false
+		}
+		found = true
+	}
+	return found
+}
+
+// Choseong 완성형 음절은 초성으로 바꾸고 나머지 문자는 소문자로 유지 ("길동abc" → "ㄱㄷabc")
+// migrations 의 hangul_choseong() SQL 함수와 같은 규칙 (글자 수가 바뀌지 않음)
+func Choseong(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if IsSyllable(r) {
+			b.WriteRune(choseong[(r-syllableBase)/syllableBlock])
+			continue
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// Range 일치 구간 (rune 단위 [Start, End))
+type Range struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// MatchRanges text 에서 query 가 나타나는 모든 구간 (대소문자 무시)
+// query 가 초성으로만 이루어져 있으면 text 의 초성과 비교
+func MatchRanges(text, query string) []Range {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil
+	}
+
+	var haystack, needle []rune
+	if IsChoseongOnly(query) {
+		haystack = []rune(Choseong(text))
+		needle = []rune(strings.ReplaceAll(query, " ", ""))
+	} else {
+		haystack = []rune(strings.ToLower(text))
+		needle = []rune(strings.ToLower(query))
+	}
+	// 소문자 변환으로 글자 수가 달라지는 특수한 경우에는 구간을 신뢰할 수 없음
+	if len(haystack) != len([]rune(text)) || len(needle) == 0 {
+		return nil
+	}
+
+	var ranges []Range
+	for i := 0; i+len(needle) <= len(haystack); {
+		if runesEqual(haystack[i:i+len(needle)], needle) {
+			ranges = append(ranges, Range{Start: i, End: i + len(needle)})
+			i += len(needle)
+			continue
+		}
+		i++
+	}
+	return ranges
+}
+
+func runesEqual(a, b []rune) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
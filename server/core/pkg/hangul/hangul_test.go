@@ -0,0 +1,75 @@
+package hangul
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChoseong(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"홍길동", "ㅎㄱㄷ"},
+		{"길동abc", "ㄱㄷabc"},
+		{"Hello 세계!", "hello ㅅㄱ!"},
+		{"ㄱ가A", "ㄱㄱa"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := Choseong(tt.in); got != tt.want {
+			t.Errorf("Choseong(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestIsChoseongOnly(t *testing.T) {
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{"ㄱㄷ", true},
+		{"ㄱ ㄷ", true},
+		{"ㄲㅎ", true},
+		{"", false},
+		{"  ", false},
+		{"ㄱa", false},
+		{"가", false},
+		{"ㅏ", false}, // 모음은 초성이 아님
+	}
+	for _, tt := range tests {
+		if got := IsChoseongOnly(tt.in); got != tt.want {
+			t.Errorf("IsChoseongOnly(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestMatchRanges(t *testing.T) {
+	tests := []struct {
+		text  string
+		query string
+		want  []Range
+	}{
+		// 일반 검색 (한글/영문 혼합, 대소문자 무시)
+		{"홍길동", "길동", []Range{{1, 3}}},
+		{"Step홍길동step", "STEP", []Range{{0, 4}, {7, 11}}},
+		{"Gil길동", "l길", []Range{{2, 4}}},
+		{"aaaa", "aa", []Range{{0, 2}, {2, 4}}},
+
+		// 초성 검색
+		{"홍길동", "ㄱㄷ", []Range{{1, 3}}},
+		{"길동 Gil길동", "ㄱ ㄷ", []Range{{0, 2}, {6, 8}}},
+		{"Hong홍길동", "ㅎ", []Range{{4, 5}}},
+		{"홍길동", "ㅎ길", nil}, // 초성과 음절이 섞이면 일반 검색
+
+		// 일치 없음
+		{"홍길동", "", nil},
+		{"홍길동", "  ", nil},
+		{"홍길동", "김", nil},
+	}
+	for _, tt := range tests {
+		if got := MatchRanges(tt.text, tt.query); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("MatchRanges(%q, %q) = %v, want %v", tt.text, tt.query, got, tt.want)
+		}
+	}
+}
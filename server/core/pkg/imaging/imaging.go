@@ -0,0 +1,124 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"io"
+	"net/http"
+
+	_ "image/gif" // image.Decode 포맷 등록
+	_ "image/png"
+
+	"github.com/pkg/errors"
+)
+
+// ErrUnsupportedFormat JPEG/PNG/GIF 외 포맷이거나 디코딩할 수 없는 데이터
+var ErrUnsupportedFormat = errors.New("unsupported image format")
+
+// ErrTooManyPixels 압축 폭탄 방지용 픽셀 수 제한 초과
+var ErrTooManyPixels = errors.New("image dimensions too large")
+
+// 파일 확장자/Content-Type 헤더가 아닌 실제 바이트로 판별
+var supportedContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+}
+
+// DetectContentType 앞부분 바이트로 이미지 타입을 판별 (지원하지 않으면 ok=false)
+func DetectContentType(data []byte) (string, bool) {
+	contentType := http.DetectContentType(data)
+	return contentType, supportedContentTypes[contentType]
+}
+
+// Decode 크기(DecodeConfig)를 먼저 확인한 뒤 디코딩하고 EXIF 방향을 반영
+// 재인코딩하면 EXIF(GPS 등) 메타데이터는 모두 제거됨
+func Decode(data []byte, maxPixels int) (image.Image, error) {
+	if _, ok := DetectContentType(data); !ok {
+		return nil, ErrUnsupportedFormat
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrap(ErrUnsupportedFormat, err.Error())
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxPixels {
+		return nil, ErrTooManyPixels
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrap(ErrUnsupportedFormat, err.Error())
+	}
+	return applyOrientation(img, jpegOrientation(data)), nil
+}
+
+// SquareThumbnail 가운데를 정사각형으로 잘라 size×size 로 축소 (투명 영역은 흰색으로 채움)
+func SquareThumbnail(img image.Image, size int) *image.RGBA {
+	b := img.Bounds()
+	side := b.Dx()
+	if b.Dy() < side {
+		side = b.Dy()
+	}
+	crop := image.Rect(0, 0, side, side).Add(image.Pt(
+		b.Min.X+(b.Dx()-side)/2,
+		b.Min.Y+(b.Dy()-side)/2,
+	))
+
+	src := image.NewRGBA(image.Rect(0, 0, side, side))
+	draw.Draw(src, src.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(src, src.Bounds(), img, crop.Min, draw.Over)
+
+	return resizeBox(src, size, size)
+}
+
+// EncodeJPEG 메타데이터 없이 JPEG 로 인코딩
+func EncodeJPEG(w io.Writer, img image.Image, quality int) error {
+	if err := jpeg.Encode(w, img, &jpeg.Options{Quality: quality}); err != nil {
+		return errors.Wrap(err, "[EncodeJPEG] encode failed")
+	}
+	return nil
+}
+
+// resizeBox 대상 픽셀이 덮는 원본 영역의 평균값 (축소 시 계단 현상이 적고, 확대 시에는 최근접 이웃)
+func resizeBox(src *image.RGBA, width, height int) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	sw, sh := src.Bounds().Dx(), src.Bounds().Dy()
+
+	for y := 0; y < height; y++ {
+		y0 := y * sh / height
+		y1 := (y + 1) * sh / height
+		if y1 <= y0 {
+			y1 = y0 + 1
+		}
+		for x := 0; x < width; x++ {
+			x0 := x * sw / width
+			x1 := (x + 1) * sw / width
+			if x1 <= x0 {
+				x1 = x0 + 1
+			}
+
+			var r, g, bl, a, n uint32
+			for sy := y0; sy < y1; sy++ {
+				off := src.PixOffset(x0, sy)
+				for sx := x0; sx < x1; sx++ {
+					r += uint32(src.Pix[off])
+					g += uint32(src.Pix[off+1])
+					bl += uint32(src.Pix[off+2])
+					a += uint32(src.Pix[off+3])
+					off += 4
+					n++
+				}
+			}
+			i := dst.PixOffset(x, y)
+			dst.Pix[i] = uint8(r / n)
+			dst.Pix[i+1] = uint8(g / n)
+			dst.Pix[i+2] = uint8(bl / n)
+			dst.Pix[i+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
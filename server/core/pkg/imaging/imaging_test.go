@@ -0,0 +1,219 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+// testImage 왼쪽 절반 빨강, 오른쪽 절반 파랑
+func testImage(w, h int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= w/2 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 95}); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// withExifOrientation SOI 바로 뒤에 Orientation 태그 하나만 있는 APP1(Exif) 세그먼트 삽입
+func withExifOrientation(jpg []byte, orientation uint16) []byte {
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	tiff = binary.BigEndian.AppendUint16(tiff, 1) // IFD entry 수
+	tiff = binary.BigEndian.AppendUint16(tiff, exifOrientationTag)
+	tiff = binary.BigEndian.AppendUint16(tiff, 3) // SHORT
+	tiff = binary.BigEndian.AppendUint32(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0, 0, 0, 0, 0) // 값 패딩 + 다음 IFD 없음
+
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	app1 := []byte{0xFF, 0xE1}
+	app1 = binary.BigEndian.AppendUint16(app1, uint16(len(segment)+2))
+	app1 = append(app1, segment...)
+
+	out := append([]byte{}, jpg[:2]...)
+	out = append(out, app1...)
+	return append(out, jpg[2:]...)
+}
+
+func TestDetectContentType(t *testing.T) {
+	var gifBuf bytes.Buffer
+	if err := gif.Encode(&gifBuf, testImage(4, 4), nil); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		data   []byte
+		want   string
+		wantOK bool
+	}{
+		{"png", encodePNG(t, testImage(4, 4)), "image/png", true},
+		{"jpeg", encodeJPEG(t, testImage(4, 4)), "image/jpeg", true},
+		{"gif", gifBuf.Bytes(), "image/gif", true},
+		{"webp is not supported", []byte("RIFF\x00\x00\x00\x00WEBPVP8 "), "image/webp", false},
+		{"svg is not supported", []byte(`<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg"></svg>`), "text/xml; charset=utf-8", false},
+		{"text", []byte("hello"), "text/plain; charset=utf-8", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := DetectContentType(tt.data)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("DetectContentType = (%q, %v), want (%q, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	valid := encodePNG(t, testImage(40, 30))
+	tests := []struct {
+		name      string
+		data      []byte
+		maxPixels int
+		wantErr   error
+		wantSize  image.Point
+	}{
+		{name: "png", data: valid, maxPixels: 40 * 30, wantSize: image.Pt(40, 30)},
+		{name: "jpeg", data: encodeJPEG(t, testImage(40, 30)), maxPixels: 10000, wantSize: image.Pt(40, 30)},
+		{name: "pixel limit exceeded", data: valid, maxPixels: 40*30 - 1, wantErr: ErrTooManyPixels},
+		{name: "not an image", data: []byte("plain text, not an image"), maxPixels: 10000, wantErr: ErrUnsupportedFormat},
+		{name: "truncated png", data: valid[:len(valid)/2], maxPixels: 10000, wantErr: ErrUnsupportedFormat},
+		{name: "png header only", data: valid[:16], maxPixels: 10000, wantErr: ErrUnsupportedFormat},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			img, err := Decode(tt.data, tt.maxPixels)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Decode: %v", err)
+			}
+			if got := img.Bounds().Size(); got != tt.wantSize {
+				t.Errorf("size = %v, want %v", got, tt.wantSize)
+			}
+		})
+	}
+}
+
+// TestDecodePixelLimitBeforeDecode 헤더의 크기만 보고 거부하므로 거대한 이미지도 디코딩하지 않음
+func TestDecodePixelLimitBeforeDecode(t *testing.T) {
+	// 20000×20000 PNG 헤더 (IHDR 까지만, 본문 없음)
+	var buf bytes.Buffer
+	buf.WriteString("\x89PNG\r\n\x1a\n")
+	ihdr := binary.BigEndian.AppendUint32(nil, 20000)
+	ihdr = binary.BigEndian.AppendUint32(ihdr, 20000)
+	ihdr = append(ihdr, 8, 6, 0, 0, 0)
+	buf.Write(binary.BigEndian.AppendUint32(nil, uint32(len(ihdr))))
+	buf.WriteString("IHDR")
+	buf.Write(ihdr)
+	buf.Write(binary.BigEndian.AppendUint32(nil, crc32.ChecksumIEEE(append([]byte("IHDR"), ihdr...))))
+
+	if _, err := Decode(buf.Bytes(), 4096*4096); !errors.Is(err, ErrTooManyPixels) {
+		t.Errorf("err = %v, want ErrTooManyPixels", err)
+	}
+}
+
+func TestDecodeAppliesExifOrientation(t *testing.T) {
+	base := encodeJPEG(t, testImage(40, 20))
+	tests := []struct {
+		orientation uint16
+		wantSize    image.Point
+	}{
+		{1, image.Pt(40, 20)},
+		{3, image.Pt(40, 20)},
+		{6, image.Pt(20, 40)},
+		{8, image.Pt(20, 40)},
+	}
+	for _, tt := range tests {
+		data := withExifOrientation(base, tt.orientation)
+		if got := jpegOrientation(data); got != int(tt.orientation) {
+			t.Fatalf("jpegOrientation = %d, want %d", got, tt.orientation)
+		}
+		img, err := Decode(data, 10000)
+		if err != nil {
+			t.Fatalf("orientation %d: Decode: %v", tt.orientation, err)
+		}
+		if got := img.Bounds().Size(); got != tt.wantSize {
+			t.Errorf("orientation %d: size = %v, want %v", tt.orientation, got, tt.wantSize)
+		}
+	}
+
+	// 6 (시계 방향 90도): 원본 왼쪽(빨강)이 위로
+	img, err := Decode(withExifOrientation(base, 6), 10000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r, _, b, _ := img.At(10, 2).RGBA(); r < b {
+		t.Errorf("top of rotated image is not red (r=%d, b=%d)", r, b)
+	}
+	if r, _, b, _ := img.At(10, 37).RGBA(); b < r {
+		t.Errorf("bottom of rotated image is not blue (r=%d, b=%d)", r, b)
+	}
+}
+
+// TestReencodeStripsMetadata 썸네일을 다시 인코딩하면 EXIF 가 남지 않고 지정한 크기의 JPEG 가 됨
+func TestReencodeStripsMetadata(t *testing.T) {
+	src := withExifOrientation(encodeJPEG(t, testImage(60, 40)), 6)
+	img, err := Decode(src, 10000)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+
+	var out bytes.Buffer
+	if err := EncodeJPEG(&out, SquareThumbnail(img, 16), 85); err != nil {
+		t.Fatalf("EncodeJPEG: %v", err)
+	}
+	if bytes.Contains(out.Bytes(), []byte("Exif\x00\x00")) {
+		t.Error("re-encoded JPEG still contains an Exif segment")
+	}
+	if got := jpegOrientation(out.Bytes()); got != orientationNormal {
+		t.Errorf("re-encoded orientation = %d, want %d", got, orientationNormal)
+	}
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(out.Bytes()))
+	if err != nil || format != "jpeg" || cfg.Width != 16 || cfg.Height != 16 {
+		t.Errorf("re-encoded = %s %dx%d (err=%v), want jpeg 16x16", format, cfg.Width, cfg.Height, err)
+	}
+}
+
+func TestSquareThumbnailFillsTransparency(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 8, 8)) // 전부 투명
+	thumb := SquareThumbnail(img, 4)
+	if got := thumb.RGBAAt(1, 1); got != (color.RGBA{255, 255, 255, 255}) {
+		t.Errorf("transparent pixel = %v, want white", got)
+	}
+}
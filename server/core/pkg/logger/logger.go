@@ -1,6 +1,7 @@
 package logger
 
 import (
+	"context"
 	"os"
 	"time"
 
@@ -40,5 +41,18 @@ func InitLogger(env string) {
 		)
 	}
 
+	// context 에 요청 로거가 없으면 (백그라운드 작업, CLI) log.Ctx(ctx) 가 전역 로거를 사용
+	zerolog.DefaultContextLogger = &log.Logger
+
 	log.Info().Msgf("[InitLogger] Logging Level: %s (env=%s)", level.String(), env)
 }
+
+// UpdateContext 요청 로거(AccessLog 미들웨어가 context 에 넣은 로거)에 필드 추가 (인증된 user_id, 라우트 패턴 등)
+// 요청 로거가 없는 context 면 전역 로거가 바뀌지 않도록 아무것도 하지 않음
+func UpdateContext(ctx context.Context, update func(c zerolog.Context) zerolog.Context) {
+	l := zerolog.Ctx(ctx)
+	if l == zerolog.DefaultContextLogger || l.GetLevel() == zerolog.Disabled {
+		return
+	}
+	l.UpdateContext(update)
+}
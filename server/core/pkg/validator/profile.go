@@ -0,0 +1,150 @@
+package validator
+
+import (
+	"strings"
+	"time"
+	_ "time/tzdata" // 런타임 이미지(alpine)에 zoneinfo 가 없어도 타임존 검증이 가능하도록 내장
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	NicknameMinLen    = 2
+	NicknameMaxLen    = 20
+	DisplayNameMaxLen = 30
+	BioMaxLen         = 300
+	HandleMinLen      = 3
+	HandleMaxLen      = 30
+)
+
+// SupportedLocales 설정(preference "locale")에 사용 가능한 로케일
+var SupportedLocales = []string{"ko-KR", "en-US", "ja-JP"}
+
+// ValidateNickname 2~20자, 한글(완성형)/영문/숫자/밑줄/마침표만 허용, 비속어·예약어 불가
+// 반환값이 빈 문자열이면 통과, 아니면 실패 사유
+func (v *Validator) ValidateNickname(nickname string) string {
+	length := utf8.RuneCountInString(nickname)
+	if length < NicknameMinLen || length > NicknameMaxLen {
+		return "닉네임은 2자 이상 20자 이하여야 합니다"
+	}
+	for _, r := range nickname {
+		if !isHangulSyllable(r) && !isASCIILetterOrDigit(r) && r != '_' && r != '.' {
+			return "닉네임에는 한글, 영문, 숫자, 밑줄(_), 마침표(.)만 사용할 수 있습니다"
+		}
+	}
+	if strings.HasPrefix(nickname, ".") || strings.HasSuffix(nickname, ".") {
+		return "닉네임은 마침표로 시작하거나 끝날 수 없습니다"
+	}
+	if v.IsReserved(nickname) {
+		return "사용할 수 없는 닉네임입니다"
+	}
+	if v.ContainsProfanity(nickname) {
+		return "부적절한 단어가 포함되어 있습니다"
+	}
+	return ""
+}
+
+// ValidateHandle 3~30자 영문 소문자/숫자/밑줄 (URL 에 그대로 사용), 예약어 불가
+// user + 숫자 형식은 가입 시 자동으로 부여하는 handle 이므로 직접 지정할 수 없음
+func (v *Validator) ValidateHandle(handle string) string {
+	if len(handle) < HandleMinLen || len(handle) > HandleMaxLen {
+		return "핸들은 3자 이상 30자 이하여야 합니다"
+	}
+	for _, r := range handle {
+		if !(r >= 'a' && r <= 'z') && !(r >= '0' && r <= '9') && r != '_' {
+			return "핸들에는 영문 소문자, 숫자, 밑줄(_)만 사용할 수 있습니다"
+		}
+	}
+	if strings.HasPrefix(handle, "_") || strings.HasSuffix(handle, "_") {
+		return "핸들은 밑줄로 시작하거나 끝날 수 없습니다"
+	}
+	if isGeneratedHandle(handle) || v.IsReserved(handle) {
+		return "사용할 수 없는 핸들입니다"
+	}
+	if v.ContainsProfanity(handle) {
+		return "부적절한 단어가 포함되어 있습니다"
+	}
+	return ""
+}
+
+func isGeneratedHandle(handle string) bool {
+	digits := strings.TrimPrefix(handle, "user")
+	if digits == handle || digits == "" {
+		return false
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateDisplayName 1~30자, 제어 문자 불가, 앞뒤 공백 불가, 비속어·예약어 불가
+func (v *Validator) ValidateDisplayName(name string) string {
+	length := utf8.RuneCountInString(name)
+	if length < 1 || length > DisplayNameMaxLen {
+		return "표시 이름은 1자 이상 30자 이하여야 합니다"
+	}
+	if strings.TrimSpace(name) != name {
+		return "표시 이름 앞뒤에 공백을 넣을 수 없습니다"
+	}
+	for _, r := range name {
+		if unicode.IsControl(r) || (!unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsSpace(r) && !strings.ContainsRune("_.-'", r)) {
+			return "표시 이름에 사용할 수 없는 문자가 포함되어 있습니다"
+		}
+	}
+	if v.IsReserved(name) {
+		return "사용할 수 없는 이름입니다"
+	}
+	if v.ContainsProfanity(name) {
+		return "부적절한 단어가 포함되어 있습니다"
+	}
+	return ""
+}
+
+// ValidateBio 300자 이하, 줄바꿈 외 제어 문자 불가, 비속어 불가
+func (v *Validator) ValidateBio(bio string) string {
+	if utf8.RuneCountInString(bio) > BioMaxLen {
+		return "소개는 300자 이하여야 합니다"
+	}
+	for _, r := range bio {
+		if unicode.IsControl(r) && r != '\n' {
+			return "소개에 사용할 수 없는 문자가 포함되어 있습니다"
+		}
+	}
+	if v.ContainsProfanity(bio) {
+		return "부적절한 단어가 포함되어 있습니다"
+	}
+	return ""
+}
+
+// ValidateLocale 지원 로케일(SupportedLocales)만 허용
+func (v *Validator) ValidateLocale(locale string) string {
+	for _, l := range SupportedLocales {
+		if l == locale {
+			return ""
+		}
+	}
+	return "지원하지 않는 로케일입니다 (" + strings.Join(SupportedLocales, ", ") + ")"
+}
+
+// ValidateTimezone IANA 타임존 이름 (예: Asia/Seoul)
+func (v *Validator) ValidateTimezone(tz string) string {
+	if tz == "" || tz == "Local" {
+		return "타임존을 입력해주세요 (예: Asia/Seoul)"
+	}
+	if _, err := time.LoadLocation(tz); err != nil {
+		return "알 수 없는 타임존입니다 (예: Asia/Seoul)"
+	}
+	return ""
+}
+
+// isHangulSyllable 완성형 한글 음절 (가-힣), 자모 단독 입력(ㅋㅋ 등)은 제외
+func isHangulSyllable(r rune) bool {
+	return r >= '가' && r <= '힣'
+}
+
+func isASCIILetterOrDigit(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+}
@@ -0,0 +1,146 @@
+package validator
+
+import (
+	"fmt"
+	"net/mail"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// RuleFunc `validate` 태그 규칙 하나
+// field 는 포인터를 벗긴 필드 값, param 은 "max=20" 의 "20" (없으면 빈 문자열)
+// 반환값이 빈 문자열이면 통과, 아니면 실패 사유
+type RuleFunc func(v *Validator, field reflect.Value, param string) string
+
+// ruleRegex 정규식 규칙 이름 (패턴에 쉼표가 들어갈 수 있어 태그의 마지막 규칙이어야 함)
+const ruleRegex = "regex"
+
+var regexCache sync.Map // pattern → *regexp.Regexp
+
+// builtinRules 기본 규칙 (required 는 값이 비었을 때 나머지 규칙을 건너뛸지 결정해야 해서 Struct 에서 따로 처리)
+func builtinRules() map[string]RuleFunc {
+	return map[string]RuleFunc{
+		"min":      ruleMin,
+		"max":      ruleMax,
+		"email":    ruleEmail,
+		"enum":     ruleEnum,
+		ruleRegex:  ruleRegexp,
+		"nickname": stringRule((*Validator).ValidateNickname),
+		"handle":   stringRule((*Validator).ValidateHandle),
+		"locale":   stringRule((*Validator).ValidateLocale),
+		"timezone": stringRule((*Validator).ValidateTimezone),
+	}
+}
+
+// RegisterRule 사용자 정의 규칙 추가 (같은 이름이면 덮어씀), 서버 시작 시점에만 호출
+func (v *Validator) RegisterRule(name string, rule RuleFunc) {
+	v.rules[name] = rule
+}
+
+// stringRule 기존 ValidateXxx(string) string 검증 함수를 태그 규칙으로 사용
+func stringRule(fn func(v *Validator, s string) string) RuleFunc {
+	return func(v *Validator, field reflect.Value, _ string) string {
+		if field.Kind() != reflect.String {
+			return ""
+		}
+		return fn(v, field.String())
+	}
+}
+
+// ruleMin 문자열은 글자 수, 슬라이스/맵은 원소 수, 숫자는 값
+func ruleMin(_ *Validator, field reflect.Value, param string) string {
+	n, isLen, ok := measure(field)
+	limit, err := strconv.ParseFloat(param, 64)
+	if !ok || err != nil {
+		return ""
+	}
+	if n >= limit {
+		return ""
+	}
+	if isLen {
+		return fmt.Sprintf("%s 이상이어야 합니다", lengthUnit(field, param))
+	}
+	return fmt.Sprintf("%s 이상이어야 합니다", param)
+}
+
+func ruleMax(_ *Validator, field reflect.Value, param string) string {
+	n, isLen, ok := measure(field)
+	limit, err := strconv.ParseFloat(param, 64)
+	if !ok || err != nil {
+		return ""
+	}
+	if n <= limit {
+		return ""
+	}
+	if isLen {
+		return fmt.Sprintf("%s 이하여야 합니다", lengthUnit(field, param))
+	}
+	return fmt.Sprintf("%s 이하여야 합니다", param)
+}
+
+func measure(field reflect.Value) (n float64, isLen bool, ok bool) {
+	switch field.Kind() {
+	case reflect.String:
+		return float64(utf8.RuneCountInString(field.String())), true, true
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return float64(field.Len()), true, true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(field.Int()), false, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(field.Uint()), false, true
+	case reflect.Float32, reflect.Float64:
+		return field.Float(), false, true
+	}
+	return 0, false, false
+}
+
+func lengthUnit(field reflect.Value, param string) string {
+	if field.Kind() == reflect.String {
+		return param + "자"
+	}
+	return param + "개"
+}
+
+func ruleEmail(_ *Validator, field reflect.Value, _ string) string {
+	if field.Kind() != reflect.String || field.String() == "" {
+		return ""
+	}
+	addr, err := mail.ParseAddress(field.String())
+	if err != nil || addr.Address != field.String() {
+		return "올바른 이메일 형식이 아닙니다"
+	}
+	return ""
+}
+
+// ruleEnum enum=PUBLIC|FOLLOWERS|PRIVATE
+func ruleEnum(_ *Validator, field reflect.Value, param string) string {
+	if field.Kind() != reflect.String {
+		return ""
+	}
+	allowed := strings.Split(param, "|")
+	for _, a := range allowed {
+		if field.String() == a {
+			return ""
+		}
+	}
+	return "다음 값 중 하나여야 합니다: " + strings.Join(allowed, ", ")
+}
+
+// ruleRegexp regex=^[a-z0-9_]+$ (빈 문자열은 통과, 필수 여부는 required 로 지정)
+func ruleRegexp(_ *Validator, field reflect.Value, param string) string {
+	if field.Kind() != reflect.String || field.String() == "" {
+		return ""
+	}
+	re, ok := regexCache.Load(param)
+	if !ok {
+		re, _ = regexCache.LoadOrStore(param, regexp.MustCompile(param))
+	}
+	if !re.(*regexp.Regexp).MatchString(field.String()) {
+		return "형식이 올바르지 않습니다"
+	}
+	return ""
+}
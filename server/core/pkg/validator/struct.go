@@ -0,0 +1,182 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 구조체 타입별로 파싱한 태그 캐시 (reflect.Type → []fieldSpec)
+var structCache sync.Map
+
+type fieldSpec struct {
+	index    int
+	name     string // 에러에 표시할 이름 (json 태그 우선)
+	required bool
+	rules    []Rule
+}
+
+// Rule 태그의 규칙 하나 (예: "max=20" → {Name: "max", Param: "20"})
+type Rule struct {
+	Name  string
+	Param string
+}
+
+// Struct `validate` 태그로 구조체 필드 검증, 실패가 있으면 Errors
+//
+//	type req struct {
+//		Email string  `json:"email" validate:"required,email"`
+//		Role  string  `json:"role" validate:"required,enum=USER|ADMIN"`
+//		Bio   *string `json:"bio" validate:"max=300"` // nil 이면 건너뜀
+//		Code  string  `json:"code" validate:"regex=^[A-Z]{3}$"` // regex 는 항상 마지막
+//	}
+//
+// 중첩 구조체와 구조체 슬라이스도 검증하며, 필드 이름은 "items[0].name" 형태
+// 등록되지 않은 규칙 이름은 코드 실수이므로 panic
+func (v *Validator) Struct(s interface{}) error {
+	var errs Errors
+	v.validateStruct(reflect.ValueOf(s), "", &errs)
+	return errs.Err()
+}
+
+func (v *Validator) validateStruct(val reflect.Value, prefix string, errs *Errors) {
+	for val.Kind() == reflect.Pointer {
+		if val.IsNil() {
+			return
+		}
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		return
+	}
+
+	for _, spec := range v.specsOf(val.Type()) {
+		field := val.Field(spec.index)
+		name := prefix + spec.name
+
+		if isEmpty(field) {
+			if spec.required {
+				errs.Add(name, "필수 항목입니다")
+			}
+			continue
+		}
+		for field.Kind() == reflect.Pointer || field.Kind() == reflect.Interface {
+			field = field.Elem()
+		}
+		for _, rule := range spec.rules {
+			if reason := v.rules[rule.Name](v, field, rule.Param); reason != "" {
+				errs.Add(name, reason)
+				break
+			}
+		}
+		v.validateNested(field, name, errs)
+	}
+}
+
+// validateNested 구조체, 구조체 슬라이스 필드는 안쪽까지 검증 (time.Time 제외)
+func (v *Validator) validateNested(field reflect.Value, name string, errs *Errors) {
+	switch field.Kind() {
+	case reflect.Struct:
+		if field.Type() != reflect.TypeOf(time.Time{}) {
+			v.validateStruct(field, name+".", errs)
+		}
+	case reflect.Slice, reflect.Array:
+		elem := field.Type().Elem()
+		for elem.Kind() == reflect.Pointer {
+			elem = elem.Elem()
+		}
+		if elem.Kind() != reflect.Struct || elem == reflect.TypeOf(time.Time{}) {
+			return
+		}
+		for i := 0; i < field.Len(); i++ {
+			v.validateStruct(field.Index(i), name+"["+strconv.Itoa(i)+"].", errs)
+		}
+	}
+}
+
+func (v *Validator) specsOf(t reflect.Type) []fieldSpec {
+	if cached, ok := structCache.Load(t); ok {
+		return v.checkRules(t, cached.([]fieldSpec))
+	}
+	specs := make([]fieldSpec, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := jsonName(f)
+		if name == "-" {
+			continue
+		}
+		spec := fieldSpec{index: i, name: name}
+		spec.required, spec.rules = ParseTag(f.Tag.Get("validate"))
+		specs = append(specs, spec)
+	}
+	structCache.Store(t, specs)
+	return v.checkRules(t, specs)
+}
+
+// checkRules 태그에 적힌 규칙이 이 Validator 에 등록되어 있는지 확인
+func (v *Validator) checkRules(t reflect.Type, specs []fieldSpec) []fieldSpec {
+	for _, spec := range specs {
+		for _, rule := range spec.rules {
+			if _, ok := v.rules[rule.Name]; !ok {
+				panic(fmt.Sprintf("[validator.Struct] unknown rule %q on %s.%s", rule.Name, t.Name(), spec.name))
+			}
+		}
+	}
+	return specs
+}
+
+// ParseTag "required,min=2,max=20,regex=^a,b$" → required, [min=2 max=20 regex=^a,b$]
+// (OpenAPI 스키마 생성 등 태그를 해석해야 하는 곳에서도 사용)
+func ParseTag(tag string) (bool, []Rule) {
+	var required bool
+	var rules []Rule
+	for tag != "" {
+		var part string
+		if strings.HasPrefix(tag, ruleRegex+"=") {
+			part, tag = tag, ""
+		} else if i := strings.IndexByte(tag, ','); i >= 0 {
+			part, tag = tag[:i], tag[i+1:]
+		} else {
+			part, tag = tag, ""
+		}
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if part == "required" {
+			required = true
+			continue
+		}
+		name, param, _ := strings.Cut(part, "=")
+		rules = append(rules, Rule{Name: name, Param: param})
+	}
+	return required, rules
+}
+
+func jsonName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	if name == "" {
+		return f.Name
+	}
+	return name
+}
+
+// isEmpty nil 포인터/슬라이스/맵, 공백뿐인 문자열, 0 값 (bool false 포함)
+// 값 자체가 의미 있는 false/0 을 필수로 받으려면 포인터 필드를 사용
+func isEmpty(field reflect.Value) bool {
+	switch field.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return field.IsNil()
+	case reflect.String:
+		return strings.TrimSpace(field.String()) == ""
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return field.Len() == 0
+	}
+	return field.IsZero()
+}
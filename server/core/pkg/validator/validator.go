@@ -1,12 +1,52 @@
 package validator
 
-type Validator struct{}
+import (
+	"strings"
+)
+
+// Validator 필드별 검증 함수(ValidateXxx)와 `validate` 태그 규칙 엔진 (Struct)
+type Validator struct {
+	rules map[string]RuleFunc
+}
 
 func NewValidator() *Validator {
-	return &Validator{}
+	return &Validator{rules: builtinRules()}
 }
 
 func (v *Validator) ValidateUsername(username string) bool {
 	// 간단 예시: 비어있지 않은지만 체크
 	return username != ""
 }
+
+// FieldError 필드 단위 검증 실패 사유
+type FieldError struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+}
+
+// Errors 여러 필드의 검증 실패를 모아서 한 번에 반환
+type Errors []FieldError
+
+func (e Errors) Error() string {
+	parts := make([]string, 0, len(e))
+	for _, fe := range e {
+		parts = append(parts, fe.Field+": "+fe.Reason)
+	}
+	return "validation failed: " + strings.Join(parts, ", ")
+}
+
+// Add reason 이 비어있지 않으면 추가 (검증 함수 결과를 바로 넘기기 위함)
+func (e *Errors) Add(field, reason string) {
+	if reason == "" {
+		return
+	}
+	*e = append(*e, FieldError{Field: field, Reason: reason})
+}
+
+// Err 실패가 없으면 nil (nil 슬라이스를 error 인터페이스로 반환하는 실수 방지)
+func (e Errors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
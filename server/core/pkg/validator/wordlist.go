@@ -0,0 +1,76 @@
+package validator
+
+import (
+	"strings"
+	"unicode"
+)
+
+// 비속어 목록 (정규화 후 부분 일치로 검사, 다른 단어 안에 들어가도 비속어로 보는 것만)
+var profanityWords = []string{
+	"시발", "씨발", "ㅅㅂ", "씨바", "병신", "ㅂㅅ", "븅신", "좆", "존나",
+	"개새끼", "미친놈", "미친년", "지랄", "ㅈㄹ", "닥쳐", "엠창", "느금",
+	"fuck", "shit", "bitch", "asshole",
+}
+
+// 짧아서 평범한 단어 안에 자주 들어가는 비속어 (미니미, 애비뉴, 졸라맨 등)
+// 공백/특수문자로 나눈 단어 하나와 완전히 같을 때만 비속어로 봄
+var profanityTokens = []string{"졸라", "개새", "니미", "애미", "애비"}
+
+// 예약어 (운영자 사칭 방지, 정규화 후 완전 일치)
+var reservedWords = []string{
+	"admin", "administrator", "root", "system", "support", "help", "official", "staff",
+	"moderator", "stepjourney", "null", "undefined", "me", "api",
+	"관리자", "운영자", "운영팀", "스텝저니", "공식", "고객센터",
+}
+
+// 부분 일치만으로도 사칭으로 보는 예약어
+var reservedSubstrings = []string{"admin", "관리자", "운영자", "stepjourney", "스텝저니"}
+
+// ContainsProfanity 공백/특수문자로 끊어 쓴 우회 표현(시 발, 시.발)까지 감지하도록 정규화 후 검사
+// profanityTokens 는 오탐을 줄이기 위해 단어 단위로만 비교
+func (v *Validator) ContainsProfanity(text string) bool {
+	normalized := normalizeWord(text)
+	for _, w := range profanityWords {
+		if strings.Contains(normalized, w) {
+			return true
+		}
+	}
+	tokens := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, token := range tokens {
+		for _, w := range profanityTokens {
+			if token == w {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsReserved 예약어 또는 운영자 사칭 문자열인지
+func (v *Validator) IsReserved(text string) bool {
+	normalized := normalizeWord(text)
+	for _, w := range reservedWords {
+		if normalized == w {
+			return true
+		}
+	}
+	for _, w := range reservedSubstrings {
+		if strings.Contains(normalized, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeWord 소문자화 + 문자/숫자 외 제거
+func normalizeWord(text string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(text) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,50 @@
+package validator
+
+import "testing"
+
+func TestContainsProfanity(t *testing.T) {
+	tests := []struct {
+		text string
+		want bool
+	}{
+		// 짧은 단어가 평범한 단어 안에 들어간 경우는 통과
+		{"미니미", false},
+		{"애비뉴", false},
+		{"졸라맨", false},
+		{"미니미 좋아하는 졸라맨 팬", false},
+		{"애비뉴 근처 카페", false},
+		{"hello world", false},
+
+		// 짧은 단어도 단독으로 쓰면 비속어
+		{"니미", true},
+		{"졸라 좋아", true},
+		{"진짜_애비", true},
+		{"애미!", true},
+
+		// 긴 비속어는 붙여 쓰거나 끊어 써도 감지
+		{"시발", true},
+		{"시 발", true},
+		{"씨.발놈", true},
+		{"개새끼야", true},
+		{"Shit!", true},
+		{"what the f.u.c.k", true},
+	}
+	v := NewValidator()
+	for _, tt := range tests {
+		if got := v.ContainsProfanity(tt.text); got != tt.want {
+			t.Errorf("ContainsProfanity(%q) = %v, want %v", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestValidateNicknameProfanity(t *testing.T) {
+	v := NewValidator()
+	for _, nickname := range []string{"미니미", "졸라맨", "애비뉴"} {
+		if reason := v.ValidateNickname(nickname); reason != "" {
+			t.Errorf("ValidateNickname(%q) = %q, want ok", nickname, reason)
+		}
+	}
+	if reason := v.ValidateNickname("니미"); reason == "" {
+		t.Error("ValidateNickname(니미) passed, want profanity error")
+	}
+}